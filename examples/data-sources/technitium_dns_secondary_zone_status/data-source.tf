@@ -0,0 +1,10 @@
+data "technitium_dns_secondary_zone_status" "example" {
+  zone = "example.com"
+}
+
+check "zone_transfer" {
+  assert {
+    condition     = !data.technitium_dns_secondary_zone_status.example.sync_failed
+    error_message = "The last zone transfer of example.com failed."
+  }
+}
@@ -0,0 +1,8 @@
+data "technitium_dns_zone_export" "example" {
+  zone = "example.com"
+}
+
+resource "local_file" "snapshot" {
+  filename = "${path.module}/snapshots/example.com.zone"
+  content  = data.technitium_dns_zone_export.example.content
+}
@@ -0,0 +1 @@
+terraform import technitium_dns_catalog_zone.example catalog.example
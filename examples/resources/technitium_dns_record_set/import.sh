@@ -0,0 +1 @@
+terraform import technitium_dns_record_set.www example.com/www.example.com/A
@@ -0,0 +1,20 @@
+resource "technitium_dns_zone" "example" {
+  name = "example.com"
+  type = "Primary"
+}
+
+# Round-robin A records
+resource "technitium_dns_record_set" "www" {
+  zone   = technitium_dns_zone.example.name
+  domain = "www.${technitium_dns_zone.example.name}"
+  type   = "A"
+  ttl    = 300
+  values = ["192.0.2.10", "192.0.2.11", "192.0.2.12"]
+}
+
+resource "technitium_dns_record_set" "mx" {
+  zone   = technitium_dns_zone.example.name
+  domain = technitium_dns_zone.example.name
+  type   = "MX"
+  values = ["10 mail1.example.com", "20 mail2.example.com"]
+}
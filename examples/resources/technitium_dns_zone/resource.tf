@@ -13,4 +13,12 @@ resource "technitium_dns_zone" "internal" {
 resource "technitium_dns_zone" "ptr" {
   name = "1.168.192.in-addr.arpa"
   type = "Primary"
-}
\ No newline at end of file
+}
+resource "technitium_dns_zone" "secondary" {
+  name                          = "partner.example"
+  type                          = "Secondary"
+  primary_name_server_addresses = ["192.0.2.53"]
+
+  # Change the value to force a zone transfer from the primary
+  resync_trigger = "2025-06-24"
+}
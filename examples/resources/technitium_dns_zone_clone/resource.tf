@@ -0,0 +1,4 @@
+resource "technitium_dns_zone_clone" "staging" {
+  name        = "staging.example.com"
+  source_zone = "example.com"
+}
@@ -0,0 +1,19 @@
+resource "technitium_dns_zone" "example" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_dnssec" "example" {
+  zone        = technitium_dns_zone.example.name
+  algorithm   = "ECDSA"
+  curve       = "P256"
+  nx_proof    = "NSEC3"
+  dns_key_ttl = 3600
+}
+
+output "ds_records" {
+  value = [
+    for ds in technitium_dns_zone_dnssec.example.ds_records :
+    "${ds.key_tag} ${ds.algorithm_number} ${ds.digest_type_number} ${ds.digest}"
+  ]
+}
@@ -0,0 +1 @@
+terraform import technitium_dns_zone_dnssec_key.ksk example.com/31337
@@ -0,0 +1,19 @@
+resource "technitium_dns_zone" "example" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_dnssec" "example" {
+  zone              = technitium_dns_zone.example.name
+  algorithm         = "ECDSA"
+  curve             = "P256"
+  zsk_rollover_days = 90
+}
+
+# Pre-publish a Key Signing Key with a new algorithm
+resource "technitium_dns_zone_dnssec_key" "ksk" {
+  zone      = technitium_dns_zone_dnssec.example.zone
+  key_type  = "KeySigningKey"
+  algorithm = "EDDSA"
+  curve     = "ED25519"
+}
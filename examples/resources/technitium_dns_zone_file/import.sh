@@ -0,0 +1 @@
+terraform import technitium_dns_zone_file.legacy legacy.example
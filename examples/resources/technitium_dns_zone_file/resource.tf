@@ -0,0 +1,10 @@
+resource "technitium_dns_zone" "legacy" {
+  name = "legacy.example"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_file" "legacy" {
+  zone      = technitium_dns_zone.legacy.name
+  content   = file("${path.module}/legacy.example.zone")
+  overwrite = true
+}
@@ -0,0 +1 @@
+terraform import technitium_dns_zone_options.example example.com
@@ -0,0 +1,16 @@
+resource "technitium_dns_zone" "example" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_options" "example" {
+  zone = technitium_dns_zone.example.name
+
+  zone_transfer             = "UseSpecifiedNetworkACL"
+  zone_transfer_network_acl = ["192.168.10.0/24", "!192.168.10.1"]
+
+  notify              = "SpecifiedNameServers"
+  notify_name_servers = ["192.168.10.2", "192.168.10.3"]
+
+  update = "Deny"
+}
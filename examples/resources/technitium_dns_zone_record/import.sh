@@ -0,0 +1,6 @@
+# Records are identified by zone, name, type and the record data in its zone file form
+terraform import technitium_dns_zone_record.www "example.com/www.example.com/A/192.0.2.1"
+terraform import technitium_dns_zone_record.mx "example.com/example.com/MX/10 mail.example.com"
+
+# The record data can be left out when the name has a single record of the type
+terraform import technitium_dns_zone_record.alias "example.com/alias.example.com/CNAME"
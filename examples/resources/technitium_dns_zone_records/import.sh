@@ -0,0 +1,2 @@
+# Every record of the zone is imported as a declared record
+terraform import technitium_dns_zone_records.example example.com
@@ -0,0 +1,18 @@
+resource "technitium_dns_zone" "example" {
+  name = "example.com"
+  type = "Primary"
+}
+
+# Every record of example.com that is not listed here is deleted, except the apex NS records
+resource "technitium_dns_zone_records" "example" {
+  zone = technitium_dns_zone.example.name
+  ttl  = 3600
+
+  records = [
+    { domain = "example.com", type = "A", value = "192.0.2.1" },
+    { domain = "example.com", type = "MX", value = "10 mail.example.com" },
+    { domain = "example.com", type = "TXT", value = "v=spf1 mx -all" },
+    { domain = "mail.example.com", type = "A", value = "192.0.2.25" },
+    { domain = "www.example.com", type = "CNAME", value = "example.com", ttl = 300 },
+  ]
+}
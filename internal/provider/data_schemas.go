@@ -165,6 +165,52 @@ func DnsZonesSchema() map[string]schema.Attribute {
 	}
 }
 
+func DnsSecondaryZoneStatusSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"zone": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the secondary zone.",
+		},
+		"type": schema.StringAttribute{
+			Computed: true,
+		},
+		"disabled": schema.BoolAttribute{
+			Computed: true,
+		},
+		"soa_serial": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The SOA serial of the last transferred copy of the zone.",
+		},
+		"expiry": schema.StringAttribute{
+			Computed:    true,
+			Description: "When the zone expires if it cannot be refreshed from its primary name servers. A successful refresh moves it forward.",
+		},
+		"is_expired": schema.BoolAttribute{
+			Computed: true,
+		},
+		"sync_failed": schema.BoolAttribute{
+			Computed:    true,
+			Description: "True when the last zone transfer or refresh from the primary name servers failed.",
+		},
+		"notify_failed": schema.BoolAttribute{
+			Computed: true,
+		},
+	}
+}
+
+func DnsZoneExportSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"zone": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the zone to export.",
+		},
+		"content": schema.StringAttribute{
+			Computed:    true,
+			Description: "The zone in RFC 1035 zone file format, including record types the provider does not model.",
+		},
+	}
+}
+
 func DnsZoneRecordsSchema() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
 		"domain": schema.StringAttribute{
@@ -222,6 +268,9 @@ func DnsZoneRecordSchema() map[string]schema.Attribute {
 		"expiry_ttl": schema.Int64Attribute{
 			Computed: true,
 		},
+		"comments": schema.StringAttribute{
+			Computed: true,
+		},
 		"record_data": schema.SingleNestedAttribute{
 			Computed:   true,
 			Attributes: RecordDataSchema(),
@@ -237,19 +286,19 @@ func RecordDataSchema() map[string]schema.Attribute {
 		"responsible_person": schema.StringAttribute{
 			Computed: true,
 		},
-		"serial": schema.Int32Attribute{
+		"serial": schema.Int64Attribute{
 			Computed: true,
 		},
-		"refresh": schema.Int32Attribute{
+		"refresh": schema.Int64Attribute{
 			Computed: true,
 		},
-		"retry": schema.Int32Attribute{
+		"retry": schema.Int64Attribute{
 			Computed: true,
 		},
-		"expire": schema.Int32Attribute{
+		"expire": schema.Int64Attribute{
 			Computed: true,
 		},
-		"minimum": schema.Int32Attribute{
+		"minimum": schema.Int64Attribute{
 			Computed: true,
 		},
 		"use_serial_date_scheme": schema.BoolAttribute{
@@ -261,7 +310,7 @@ func RecordDataSchema() map[string]schema.Attribute {
 		"forwarder": schema.StringAttribute{
 			Computed: true,
 		},
-		"priority": schema.Int32Attribute{
+		"priority": schema.Int64Attribute{
 			Computed: true,
 		},
 		"dnssec_validation": schema.BoolAttribute{
@@ -279,5 +328,170 @@ func RecordDataSchema() map[string]schema.Attribute {
 		"name_server": schema.StringAttribute{
 			Computed: true,
 		},
+		"proxy_address": schema.StringAttribute{
+			Computed:    true,
+			Description: "The proxy address of `FWD` records.",
+		},
+		"proxy_port": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The proxy port of `FWD` records.",
+		},
+		"proxy_username": schema.StringAttribute{
+			Computed:    true,
+			Description: "The proxy username of `FWD` records.",
+		},
+		"ptr_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The domain name of `PTR` records.",
+		},
+		"exchange": schema.StringAttribute{
+			Computed:    true,
+			Description: "The mail exchange of `MX` records.",
+		},
+		"preference": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The preference of `MX` and `NAPTR` records.",
+		},
+		"text": schema.StringAttribute{
+			Computed:    true,
+			Description: "The text of `TXT` records.",
+		},
+		"split_text": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the text of `TXT` records is split into multiple character-strings on new lines.",
+		},
+		"weight": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The weight of `SRV` and `URI` records.",
+		},
+		"port": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The port of `SRV` records.",
+		},
+		"target": schema.StringAttribute{
+			Computed:    true,
+			Description: "The target host of `SRV` records.",
+		},
+		"order": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The order of `NAPTR` records.",
+		},
+		"naptr_flags": schema.StringAttribute{
+			Computed:    true,
+			Description: "The flags of `NAPTR` records.",
+		},
+		"services": schema.StringAttribute{
+			Computed:    true,
+			Description: "The services of `NAPTR` records.",
+		},
+		"regexp": schema.StringAttribute{
+			Computed:    true,
+			Description: "The regular expression of `NAPTR` records.",
+		},
+		"replacement": schema.StringAttribute{
+			Computed:    true,
+			Description: "The replacement domain name of `NAPTR` records.",
+		},
+		"dname": schema.StringAttribute{
+			Computed:    true,
+			Description: "The target domain name of `DNAME` records.",
+		},
+		"aname": schema.StringAttribute{
+			Computed:    true,
+			Description: "The target domain name of `ANAME` records.",
+		},
+		"key_tag": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The key tag of `DS` records.",
+		},
+		"algorithm": schema.StringAttribute{
+			Computed:    true,
+			Description: "The algorithm of `DS` and `SSHFP` records.",
+		},
+		"digest_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The digest type of `DS` records.",
+		},
+		"digest": schema.StringAttribute{
+			Computed:    true,
+			Description: "The digest of `DS` records.",
+		},
+		"fingerprint_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The fingerprint type of `SSHFP` records.",
+		},
+		"fingerprint": schema.StringAttribute{
+			Computed:    true,
+			Description: "The fingerprint of `SSHFP` records.",
+		},
+		"certificate_usage": schema.StringAttribute{
+			Computed:    true,
+			Description: "The certificate usage of `TLSA` records.",
+		},
+		"selector": schema.StringAttribute{
+			Computed:    true,
+			Description: "The selector of `TLSA` records.",
+		},
+		"matching_type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The matching type of `TLSA` records.",
+		},
+		"certificate_association_data": schema.StringAttribute{
+			Computed:    true,
+			Description: "The certificate association data of `TLSA` records.",
+		},
+		"svc_priority": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The service priority of `SVCB` and `HTTPS` records.",
+		},
+		"svc_mode": schema.StringAttribute{
+			Computed:    true,
+			Description: "The mode of `SVCB` and `HTTPS` records, `AliasMode` or `ServiceMode`.",
+		},
+		"target_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The target name of `SVCB` and `HTTPS` records.",
+		},
+		"svc_params": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The service parameters of `SVCB` and `HTTPS` records.",
+		},
+		"auto_ipv4_hint": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the `ipv4hint` parameter of `SVCB` and `HTTPS` records is updated automatically.",
+		},
+		"auto_ipv6_hint": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the `ipv6hint` parameter of `SVCB` and `HTTPS` records is updated automatically.",
+		},
+		"uri": schema.StringAttribute{
+			Computed:    true,
+			Description: "The URI of `URI` records.",
+		},
+		"flags": schema.Int64Attribute{
+			Computed:    true,
+			Description: "The flags of `CAA` records.",
+		},
+		"tag": schema.StringAttribute{
+			Computed:    true,
+			Description: "The property tag of `CAA` records.",
+		},
+		"value": schema.StringAttribute{
+			Computed:    true,
+			Description: "The property value of `CAA` records.",
+		},
+		"app_name": schema.StringAttribute{
+			Computed:    true,
+			Description: "The DNS app of `APP` records.",
+		},
+		"class_path": schema.StringAttribute{
+			Computed:    true,
+			Description: "The class path of `APP` records.",
+		},
+		"data": schema.StringAttribute{
+			Computed:    true,
+			Description: "The record data of `APP` records.",
+		},
 	}
 }
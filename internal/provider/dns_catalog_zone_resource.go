@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-technitium/internal/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dnsCatalogZoneResource{}
+	_ resource.ResourceWithConfigure   = &dnsCatalogZoneResource{}
+	_ resource.ResourceWithImportState = &dnsCatalogZoneResource{}
+)
+
+func NewDnsCatalogZoneResource() resource.Resource {
+	return &dnsCatalogZoneResource{}
+}
+
+type dnsCatalogZoneResource struct {
+	client *technitium.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsCatalogZoneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = ConfigureResourceClient(req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *dnsCatalogZoneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_catalog_zone"
+}
+
+func (r *dnsCatalogZoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: DnsCatalogZoneResourceSchema(),
+		Description: "Manages a catalog zone. Secondary servers that subscribe to the catalog with a `SecondaryCatalog` zone " +
+			"automatically provision its member zones.",
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsCatalogZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	var plan dnsCatalogZone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.CreateDnsZone(technitium.DnsZoneCreate{Name: plan.Name.ValueString(), Type: "Catalog"}, ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating catalog zone",
+			"Could not create catalog zone "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.RefreshMembers(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsCatalogZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	var state dnsCatalogZone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := r.client.GetDnsZone(state.Name.ValueString(), ctx)
+	if technitium.IsZoneNotFound(err) {
+		tflog.Info(ctx, "Removing catalog zone "+state.Name.ValueString()+" from state due to error: "+err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading catalog zone",
+			"Could not read catalog zone "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if zone.Type != "Catalog" {
+		tflog.Info(ctx, "Removing catalog zone "+state.Name.ValueString()+" from state, the zone type is "+zone.Type)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(r.RefreshMembers(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only refreshes the member list, the catalog zone name forces a new resource.
+func (r *dnsCatalogZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	var plan dnsCatalogZone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.RefreshMembers(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsCatalogZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	var state dnsCatalogZone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDnsZone(state.Name.ValueString(), ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting catalog zone",
+			"Could not delete catalog zone "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *dnsCatalogZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// RefreshMembers sets the member list from the zones that reference the catalog.
+func (r *dnsCatalogZoneResource) RefreshMembers(ctx context.Context, data *dnsCatalogZone) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+
+	members, err := r.client.GetCatalogZoneMembers(data.Name.ValueString(), ctx)
+	if err != nil {
+		diags.AddError(
+			"Error reading catalog zone members",
+			"Could not read the members of catalog zone "+data.Name.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	list, d := convertStringListToListValue(ctx, members)
+	diags.Append(d...)
+	data.Members = list
+
+	return diags
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"terraform-provider-technitium/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccDnsCatalogZone(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_catalog_zone.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone.test", "catalog", "catalog.example"),
+				),
+			},
+			{
+				// The member list is refreshed once the member zone exists
+				Config: GetFileConfig(t, "dns_catalog_zone.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_catalog_zone.test", "members.#", "1"),
+					resource.TestCheckResourceAttr("technitium_dns_catalog_zone.test", "members.0", "example.com"),
+				),
+			},
+			{
+				// Leaving the catalog must not recreate the zone
+				Config: GetFileConfig(t, "dns_catalog_zone_removed.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config: GetFileConfig(t, "dns_catalog_zone_removed.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_catalog_zone.test", "members.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDnsCatalogZone_readError(t *testing.T) {
+
+	server := test.NewTestServer(test.Scenario{
+		ExpectedStatus: http.StatusOK,
+		ExpectedBody:   `{"status":"error","errorMessage":"The server is busy"}`,
+	})
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "technitium" {
+  host = "%s"
+  token = "test"
+}
+
+resource "technitium_dns_catalog_zone" "test" {
+  name = "catalog.example"
+}
+`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Errors other than a missing zone are reported instead of planning a new catalog zone
+				Config:        config,
+				ResourceName:  "technitium_dns_catalog_zone.test",
+				ImportState:   true,
+				ImportStateId: "catalog.example",
+				ExpectError:   regexp.MustCompile("Could not read catalog zone catalog.example"),
+			},
+		},
+	})
+}
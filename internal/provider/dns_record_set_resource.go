@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-technitium/internal/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsRecordSetResource{}
+	_ resource.ResourceWithConfigure      = &dnsRecordSetResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsRecordSetResource{}
+	_ resource.ResourceWithValidateConfig = &dnsRecordSetResource{}
+	_ resource.ResourceWithImportState    = &dnsRecordSetResource{}
+)
+
+func NewDnsRecordSetResource() resource.Resource {
+	return &dnsRecordSetResource{}
+}
+
+type dnsRecordSetResource struct {
+	client *technitium.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsRecordSetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = ConfigureResourceClient(req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *dnsRecordSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record_set"
+}
+
+func (r *dnsRecordSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: DnsRecordSetResourceSchema(),
+		Description: "Manages every record of a domain name and type as one unit, such as the A records of a round-robin name " +
+			"or the MX records of a domain. Records of the set that are not declared are deleted.",
+	}
+}
+
+// ValidateConfig checks that every value can be parsed and is only declared once.
+func (r *dnsRecordSetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config dnsRecordSet
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsUnknown() || config.Values.IsUnknown() || config.Values.IsNull() {
+		return
+	}
+
+	var values []types.String
+	resp.Diagnostics.Append(config.Values.ElementsAs(ctx, &values, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(values) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("values"), "Invalid record set", "A record set must have at least one value.")
+		return
+	}
+
+	if (config.Type.ValueString() == "CNAME" || config.Type.ValueString() == "DNAME") && len(values) > 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("values"), "Invalid record set", "A "+config.Type.ValueString()+" record set can only have one value.")
+		return
+	}
+
+	seen := make(map[string]string)
+	for _, value := range values {
+		if value.IsUnknown() {
+			continue
+		}
+		normalized, err := technitium.NormalizeRecordValue(config.Type.ValueString(), value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("values"), "Invalid record value", err.Error())
+			continue
+		}
+		if other, ok := seen[normalized]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("values"), "Duplicate record value",
+				fmt.Sprintf("The values %q and %q are the same record.", other, value.ValueString()))
+			continue
+		}
+		seen[normalized] = value.ValueString()
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsRecordSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	var plan dnsRecordSet
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The record set is authoritative, so records of the name and type that already exist are reconciled as well
+	err := r.UpdateRecordSet(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating record set",
+			"Could not create record set "+plan.Domain.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsRecordSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	var state dnsRecordSet
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := r.client.GetDnsZoneRecordSet(state.Domain.ValueString(), state.Type.ValueString(), ctx)
+	if err == nil && len(records) == 0 {
+		err = fmt.Errorf("no %s records found for domain: %s", state.Type.ValueString(), state.Domain.ValueString())
+	}
+	if err != nil {
+		tflog.Info(ctx, "Removing record set "+state.Domain.ValueString()+" from state due to error: "+err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	current, diags := recordSetValues(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Keep the configured spelling of values that did not change
+	configured := make(map[string]string, len(current))
+	for _, value := range current {
+		configured[value.Normalized] = value.Value
+	}
+
+	values := make([]string, 0, len(records))
+	for _, record := range records {
+		value := technitium.RecordValue(record)
+		if spelling, ok := configured[value]; ok {
+			value = spelling
+		}
+		values = append(values, value)
+	}
+
+	state.TTL = types.Int64Value(records[0].TTL)
+	state.Values, diags = types.SetValueFrom(ctx, types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsRecordSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	var plan dnsRecordSet
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.UpdateRecordSet(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating record set",
+			"Could not update record set "+plan.Domain.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// UpdateRecordSet deletes the live records that are not planned, adds the planned records that are
+// missing and applies the TTL to the records that are kept.
+func (r *dnsRecordSetResource) UpdateRecordSet(ctx context.Context, plan *dnsRecordSet) error {
+
+	values, diags := recordSetValues(ctx, *plan)
+	if diags.HasError() {
+		return fmt.Errorf("invalid record set values")
+	}
+
+	records, err := r.client.GetDnsZoneRecordSet(plan.Domain.ValueString(), plan.Type.ValueString(), ctx)
+	if err != nil {
+		return err
+	}
+
+	planned := make(map[string]bool, len(values))
+	for _, value := range values {
+		planned[value.Normalized] = true
+	}
+
+	live := make(map[string]technitium.DnsZoneRecord, len(records))
+	for _, record := range records {
+		value := technitium.RecordValue(record)
+		if planned[value] {
+			live[value] = record
+			continue
+		}
+
+		tflog.Debug(ctx, "Deleting record "+value+" of "+plan.Domain.ValueString())
+		deleted := technitium.NewDnsZoneRecordCreate(plan.Zone.ValueString(), record.Name, record.Type, record.TTL, record.RecordData)
+		err = r.client.DeleteDnsZoneRecord(deleted, ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, value := range values {
+		record, ok := live[value.Normalized]
+		if !ok {
+			tflog.Debug(ctx, "Adding record "+value.Normalized+" to "+plan.Domain.ValueString())
+			err = r.client.CreateDnsZoneRecord(newRecordSetRecord(*plan, value), ctx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !plan.TTL.IsUnknown() && record.TTL != plan.TTL.ValueInt64() {
+			update := technitium.NewDnsZoneRecordUpdate(newRecordSetRecord(*plan, value))
+			update.Comments = record.Comments
+			update.ExpiryTTL = record.ExpiryTTL
+			update.Disable = record.Disabled
+			err = r.client.UpdateDnsZoneRecord(update, ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if plan.TTL.IsUnknown() {
+		records, err = r.client.GetDnsZoneRecordSet(plan.Domain.ValueString(), plan.Type.ValueString(), ctx)
+		if err != nil {
+			return err
+		}
+		if len(records) > 0 {
+			plan.TTL = types.Int64Value(records[0].TTL)
+		}
+	}
+
+	return nil
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsRecordSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	var state dnsRecordSet
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values, diags := recordSetValues(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, value := range values {
+		err := r.client.DeleteDnsZoneRecord(newRecordSetRecord(state, value), ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting record set",
+				"Could not delete record "+value.Value+" of "+state.Domain.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *dnsRecordSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.RequiresReplace = path.Paths{
+		path.Root("domain"),
+		path.Root("zone"),
+		path.Root("type"),
+	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan dnsRecordSet
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Type.ValueString() == "CNAME" {
+		checkCnameAtApex(ctx, r.client, plan.Zone, plan.Domain, &resp.Diagnostics)
+	}
+}
+
+// recordSetValue is a value of a record set with its parsed record data.
+type recordSetValue struct {
+	Value      string
+	Normalized string
+	Data       technitium.DnsZoneRecordData
+}
+
+// ImportState imports the existing records of a name and type using an identifier of the form `zone/name/type`.
+func (r *dnsRecordSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected an import ID of the form `zone/name/type`, got `"+req.ID+"`.",
+		)
+		return
+	}
+
+	// The values are read back from the records of the set
+	state := dnsRecordSet{
+		Zone:   types.StringValue(parts[0]),
+		Domain: types.StringValue(parts[1]),
+		Type:   types.StringValue(strings.ToUpper(parts[2])),
+		Values: types.SetNull(types.StringType),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func recordSetValues(ctx context.Context, set dnsRecordSet) ([]recordSetValue, diag.Diagnostics) {
+
+	var items []string
+	diags := set.Values.ElementsAs(ctx, &items, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	values := make([]recordSetValue, 0, len(items))
+	for _, item := range items {
+		data, err := technitium.ParseRecordValue(set.Type.ValueString(), item)
+		if err != nil {
+			diags.AddAttributeError(path.Root("values"), "Invalid record value", err.Error())
+			continue
+		}
+		values = append(values, recordSetValue{
+			Value:      item,
+			Normalized: technitium.FormatRecordValue(set.Type.ValueString(), data),
+			Data:       data,
+		})
+	}
+
+	return values, diags
+}
+
+func newRecordSetRecord(set dnsRecordSet, value recordSetValue) technitium.DnsZoneRecordCreate {
+	return technitium.NewDnsZoneRecordCreate(set.Zone.ValueString(), set.Domain.ValueString(), set.Type.ValueString(), set.TTL.ValueInt64(), value.Data)
+}
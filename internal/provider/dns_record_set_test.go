@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-technitium/internal/technitium"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccDnsRecordSet(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_record_set.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_record_set.www", "values.#", "2"),
+					resource.TestCheckTypeSetElemAttr("technitium_dns_record_set.www", "values.*", "192.0.2.1"),
+					resource.TestCheckTypeSetElemAttr("technitium_dns_record_set.www", "values.*", "192.0.2.2"),
+					resource.TestCheckResourceAttr("technitium_dns_record_set.www", "ttl", "300"),
+					resource.TestCheckResourceAttr("technitium_dns_record_set.mx", "values.#", "2"),
+				),
+			},
+			{
+				// Every record is read back, so the set must not show a diff
+				Config: GetFileConfig(t, "dns_record_set.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:                         "technitium_dns_record_set.www",
+				ImportState:                          true,
+				ImportStateId:                        "example.com/www.example.com/A",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "domain",
+			},
+			{
+				// Adding and removing values updates the set in place
+				Config: GetFileConfig(t, "dns_record_set_updated.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_record_set.www", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("technitium_dns_record_set.mx", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_record_set.www", "values.#", "3"),
+					resource.TestCheckTypeSetElemAttr("technitium_dns_record_set.www", "values.*", "192.0.2.4"),
+					resource.TestCheckResourceAttr("technitium_dns_record_set.www", "ttl", "600"),
+					resource.TestCheckResourceAttr("technitium_dns_record_set.mx", "values.#", "1"),
+				),
+			},
+			{
+				Config: GetFileConfig(t, "dns_record_set_updated.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDnsRecordSet_existingRecords(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone.tf"),
+			},
+			{
+				// A record of the name and type that is not declared is deleted when the set is created
+				PreConfig: func() {
+					err := createZoneRecord(context.Background(), technitium.DnsZoneRecordCreate{
+						Domain:    "www.example.com",
+						Type:      "A",
+						Zone:      "example.com",
+						TTL:       3600,
+						IPAddress: "192.0.2.99",
+					})
+					if err != nil {
+						t.Fatalf("Error creating DNS zone record: %v", err)
+					}
+				},
+				Config: GetFileConfig(t, "dns_record_set.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_record_set.www", "values.#", "2"),
+				),
+			},
+			{
+				Config: GetFileConfig(t, "dns_record_set.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-technitium/internal/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dnsSecondaryZoneStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsSecondaryZoneStatusDataSource{}
+)
+
+func NewDnsSecondaryZoneStatusDataSource() datasource.DataSource {
+	return &dnsSecondaryZoneStatusDataSource{}
+}
+
+type dnsSecondaryZoneStatusDataSource struct {
+	client *technitium.Client
+}
+
+// Metadata returns the data source type name.
+func (d *dnsSecondaryZoneStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_secondary_zone_status"
+}
+
+// Schema defines the schema for the data source.
+func (d *dnsSecondaryZoneStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: DnsSecondaryZoneStatusSchema(),
+		Description: "Reports the refresh and transfer status of a `Secondary`, `Stub`, `SecondaryForwarder` or " +
+			"`SecondaryCatalog` zone. The server does not report the time of the last refresh or the reason of a failed " +
+			"transfer, `expiry` and `sync_failed` show whether the zone is being refreshed.",
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dnsSecondaryZoneStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var state dnsSecondaryZoneStatus
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := d.client.GetDnsZoneStatus(state.Zone.ValueString(), ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read DNS Zone Status",
+			err.Error(),
+		)
+		return
+	}
+
+	switch zone.Type {
+	case "Secondary", "Stub", "SecondaryForwarder", "SecondaryCatalog":
+	default:
+		resp.Diagnostics.AddError(
+			"Unable to Read DNS Zone Status",
+			"Zone "+zone.Name+" is a "+zone.Type+" zone, the status is only available for zones transferred from primary name servers.",
+		)
+		return
+	}
+
+	state.Type = types.StringValue(zone.Type)
+	state.Disabled = types.BoolValue(zone.Disabled)
+	state.SoaSerial = types.Int64Value(int64(zone.SoaSerial))
+	state.Expiry = types.StringValue(zone.Expiry)
+	state.IsExpired = types.BoolValue(zone.IsExpired)
+	state.SyncFailed = types.BoolValue(zone.SyncFailed)
+	state.NotifyFailed = types.BoolValue(zone.NotifyFailed)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *dnsSecondaryZoneStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = ConfigureDataSourceClient(req, resp)
+}
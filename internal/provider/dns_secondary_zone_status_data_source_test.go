@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"terraform-provider-technitium/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDnsSecondaryZoneStatusDataSource(t *testing.T) {
+
+	scenario := test.GetMockScenarioFromFile(t, "../test/mocks/dns_zones_secondary_response.json", http.StatusOK)
+	server := test.NewTestServer(scenario)
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "technitium" {
+  host = "%s"
+  token = "test"
+}
+`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `data "technitium_dns_secondary_zone_status" "test" { zone = "secondary.example" }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.technitium_dns_secondary_zone_status.test", "type", "Secondary"),
+					resource.TestCheckResourceAttr("data.technitium_dns_secondary_zone_status.test", "soa_serial", "2025062401"),
+					resource.TestCheckResourceAttr("data.technitium_dns_secondary_zone_status.test", "expiry", "2025-07-01T10:00:00Z"),
+					resource.TestCheckResourceAttr("data.technitium_dns_secondary_zone_status.test", "is_expired", "false"),
+					resource.TestCheckResourceAttr("data.technitium_dns_secondary_zone_status.test", "sync_failed", "true"),
+					resource.TestCheckResourceAttr("data.technitium_dns_secondary_zone_status.test", "zone", "secondary.example"),
+					resource.TestCheckResourceAttr("data.technitium_dns_secondary_zone_status.test", "disabled", "false"),
+					resource.TestCheckResourceAttr("data.technitium_dns_secondary_zone_status.test", "notify_failed", "false"),
+				),
+			},
+			{
+				Config:      config + `data "technitium_dns_secondary_zone_status" "test" { zone = "example.com" }`,
+				ExpectError: regexp.MustCompile(`only available for zones transferred from primary name servers`),
+			},
+		},
+	})
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-technitium/internal/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &dnsZoneCloneResource{}
+	_ resource.ResourceWithConfigure  = &dnsZoneCloneResource{}
+	_ resource.ResourceWithModifyPlan = &dnsZoneCloneResource{}
+)
+
+func NewDnsZoneCloneResource() resource.Resource {
+	return &dnsZoneCloneResource{}
+}
+
+type dnsZoneCloneResource struct {
+	client *technitium.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsZoneCloneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = ConfigureResourceClient(req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *dnsZoneCloneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_clone"
+}
+
+func (r *dnsZoneCloneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: DnsZoneCloneResourceSchema(),
+		Description: "Creates a zone as a copy of an existing zone with all of its records. " +
+			"Later changes to the source zone are not copied. Destroying this resource deletes the cloned zone.",
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsZoneCloneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	var plan dnsZoneClone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CloneDnsZone(plan.Name.ValueString(), plan.SourceZone.ValueString(), ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error cloning DNS zone",
+			"Could not clone DNS zone "+plan.SourceZone.ValueString()+" to "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	zone, err := r.client.GetDnsZone(plan.Name.ValueString(), ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS zone",
+			"Could not read DNS zone "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	plan.Type = types.StringValue(zone.Type)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsZoneCloneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	var state dnsZoneClone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := r.client.GetDnsZone(state.Name.ValueString(), ctx)
+	if technitium.IsZoneNotFound(err) {
+		tflog.Info(ctx, "Removing DNS zone "+state.Name.ValueString()+" from state due to error: "+err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS zone",
+			"Could not read DNS zone "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	state.Type = types.StringValue(zone.Type)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is not supported, every attribute change replaces the clone.
+func (r *dnsZoneCloneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	var plan dnsZoneClone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsZoneCloneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	var state dnsZoneClone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDnsZone(state.Name.ValueString(), ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting DNS zone",
+			"Could not delete DNS zone "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *dnsZoneCloneResource) ModifyPlan(_ context.Context, _ resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.RequiresReplace = path.Paths{
+		path.Root("name"),
+		path.Root("source_zone"),
+	}
+}
@@ -0,0 +1,518 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"terraform-provider-technitium/internal/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsZoneDnssecKeyResource{}
+	_ resource.ResourceWithConfigure      = &dnsZoneDnssecKeyResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsZoneDnssecKeyResource{}
+	_ resource.ResourceWithValidateConfig = &dnsZoneDnssecKeyResource{}
+	_ resource.ResourceWithImportState    = &dnsZoneDnssecKeyResource{}
+)
+
+func NewDnsZoneDnssecKeyResource() resource.Resource {
+	return &dnsZoneDnssecKeyResource{}
+}
+
+type dnsZoneDnssecKeyResource struct {
+	client *technitium.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsZoneDnssecKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = ConfigureResourceClient(req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *dnsZoneDnssecKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_dnssec_key"
+}
+
+func (r *dnsZoneDnssecKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: DnsZoneDnssecKeyResourceSchema(),
+		Description: "Manages an additional DNSSEC private key of a signed zone, e.g. to pre-publish a key with a new algorithm. " +
+			"Destroying this resource deletes the key while it is only generated and retires it otherwise.",
+	}
+}
+
+// ValidateConfig checks the key type and that the key parameters match the selected algorithm.
+func (r *dnsZoneDnssecKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config dnsZoneDnssecKey
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.KeyType.IsUnknown() && !config.KeyType.IsNull() && config.KeyType.ValueString() != "KeySigningKey" && config.KeyType.ValueString() != "ZoneSigningKey" {
+		resp.Diagnostics.AddAttributeError(path.Root("key_type"), "Invalid key type", "Valid values are `KeySigningKey` and `ZoneSigningKey`, got `"+config.KeyType.ValueString()+"`.")
+	}
+
+	if config.Algorithm.IsUnknown() || config.Algorithm.IsNull() {
+		return
+	}
+
+	switch config.Algorithm.ValueString() {
+	case "RSA":
+		if config.HashAlgorithm.IsNull() || config.KeySize.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("algorithm"), "Missing attribute", "`hash_algorithm` and `key_size` are required when `algorithm` is `RSA`.")
+		}
+		if !config.Curve.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("curve"), "Invalid attribute", "`curve` cannot be used when `algorithm` is `RSA`.")
+		}
+	case "ECDSA", "EDDSA":
+		if config.Curve.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("curve"), "Missing curve", "`curve` is required when `algorithm` is `"+config.Algorithm.ValueString()+"`.")
+		}
+		if !config.HashAlgorithm.IsNull() || !config.KeySize.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("algorithm"), "Invalid attribute", "`hash_algorithm` and `key_size` can only be used when `algorithm` is `RSA`.")
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("algorithm"), "Invalid algorithm", "Valid values are `RSA`, `ECDSA` and `EDDSA`, got `"+config.Algorithm.ValueString()+"`.")
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsZoneDnssecKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	var plan dnsZoneDnssecKey
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := plan.Zone.ValueString()
+
+	// The API does not return the key tag of a new key, so it is found by comparing the key lists
+	before, err := r.client.GetDnsZoneDnssecProperties(zone, ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNSSEC properties",
+			"Could not read DNSSEC properties of zone "+zone+": "+err.Error(),
+		)
+		return
+	}
+
+	key := technitium.DnssecPrivateKeyCreate{
+		Zone:          zone,
+		KeyType:       plan.KeyType.ValueString(),
+		RolloverDays:  plan.RolloverDays.ValueInt64(),
+		Algorithm:     plan.Algorithm.ValueString(),
+		HashAlgorithm: plan.HashAlgorithm.ValueString(),
+		KeySize:       plan.KeySize.ValueInt64(),
+		Curve:         plan.Curve.ValueString(),
+	}
+
+	err = r.client.AddDnssecPrivateKey(key, ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error adding DNSSEC private key",
+			"Could not add DNSSEC private key to zone "+zone+": "+err.Error(),
+		)
+		return
+	}
+
+	after, err := r.client.GetDnsZoneDnssecProperties(zone, ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNSSEC properties",
+			"Could not read DNSSEC properties of zone "+zone+": "+err.Error(),
+		)
+		return
+	}
+
+	keyTag, found := findNewDnssecKeyTag(before.DnssecPrivateKeys, after.DnssecPrivateKeys, key.KeyType)
+	if !found {
+		resp.Diagnostics.AddError(
+			"Error adding DNSSEC private key",
+			"Could not find the new "+key.KeyType+" of zone "+zone,
+		)
+		return
+	}
+	plan.KeyTag = types.Int64Value(keyTag)
+
+	// A rollover period of 0 is not sent when adding the key
+	if !plan.RolloverDays.IsUnknown() && plan.RolloverDays.ValueInt64() == 0 {
+		err = r.client.UpdateDnssecPrivateKey(zone, keyTag, 0, ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating DNSSEC private key",
+				"Could not update DNSSEC private key "+strconv.FormatInt(keyTag, 10)+" of zone "+zone+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.Publish.ValueBool() {
+		err = r.client.PublishAllDnssecPrivateKeys(zone, ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error publishing DNSSEC private key",
+				"Could not publish DNSSEC private keys of zone "+zone+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.Retire.ValueBool() {
+		err = r.client.RetireDnsKey(zone, keyTag, ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error retiring DNSSEC private key",
+				"Could not retire DNSSEC private key "+strconv.FormatInt(keyTag, 10)+" of zone "+zone+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	err = r.RefreshKey(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNSSEC private key",
+			"Could not read DNSSEC private key "+strconv.FormatInt(keyTag, 10)+" of zone "+zone+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsZoneDnssecKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	var state dnsZoneDnssecKey
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.RefreshKey(ctx, &state)
+	if isDnssecKeyNotFound(err) {
+		tflog.Info(ctx, "Removing DNSSEC private key from state: "+err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNSSEC private key",
+			"Could not read DNSSEC private key "+strconv.FormatInt(state.KeyTag.ValueInt64(), 10)+" of zone "+state.Zone.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsZoneDnssecKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	var plan dnsZoneDnssecKey
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state dnsZoneDnssecKey
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := plan.Zone.ValueString()
+	keyTag := state.KeyTag.ValueInt64()
+	plan.KeyTag = state.KeyTag
+
+	var err error
+	if !plan.RolloverDays.IsUnknown() && plan.RolloverDays.ValueInt64() != state.RolloverDays.ValueInt64() {
+		err = r.client.UpdateDnssecPrivateKey(zone, keyTag, plan.RolloverDays.ValueInt64(), ctx)
+	}
+	if err == nil && plan.Publish.ValueBool() && !state.Publish.ValueBool() {
+		err = r.client.PublishAllDnssecPrivateKeys(zone, ctx)
+	}
+	if err == nil && plan.Retire.ValueBool() && !state.Retire.ValueBool() {
+		err = r.client.RetireDnsKey(zone, keyTag, ctx)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating DNSSEC private key",
+			"Could not update DNSSEC private key "+strconv.FormatInt(keyTag, 10)+" of zone "+zone+": "+err.Error(),
+		)
+		return
+	}
+
+	err = r.RefreshKey(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNSSEC private key",
+			"Could not read DNSSEC private key "+strconv.FormatInt(keyTag, 10)+" of zone "+zone+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsZoneDnssecKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	var state dnsZoneDnssecKey
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone := state.Zone.ValueString()
+	keyTag := state.KeyTag.ValueInt64()
+
+	err := r.RefreshKey(ctx, &state)
+	if isDnssecKeyNotFound(err) {
+		tflog.Info(ctx, "DNSSEC private key already removed: "+err.Error())
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNSSEC private key",
+			"Could not read DNSSEC private key "+strconv.FormatInt(keyTag, 10)+" of zone "+zone+": "+err.Error(),
+		)
+		return
+	}
+
+	switch {
+	case state.State.ValueString() == "Generated":
+		err = r.client.DeleteDnssecPrivateKey(zone, keyTag, ctx)
+	case state.IsRetiring.ValueBool() || state.State.ValueString() == "Retired" || state.State.ValueString() == "Revoked":
+		tflog.Info(ctx, fmt.Sprintf("DNSSEC private key %d of zone %s is already being retired", keyTag, zone))
+	default:
+		err = r.client.RetireDnsKey(zone, keyTag, ctx)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error removing DNSSEC private key",
+			"Could not remove DNSSEC private key "+strconv.FormatInt(keyTag, 10)+" of zone "+zone+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a key using the ID format "zone/key_tag".
+func (r *dnsZoneDnssecKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	idx := strings.LastIndex(req.ID, "/")
+	if idx <= 0 {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected an import ID of the form `zone/key_tag`, got `"+req.ID+"`.",
+		)
+		return
+	}
+
+	zone := req.ID[:idx]
+	keyTag, err := strconv.ParseInt(req.ID[idx+1:], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Could not parse the key tag of `"+req.ID+"`: "+err.Error(),
+		)
+		return
+	}
+
+	properties, err := r.client.GetDnsZoneDnssecProperties(zone, ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNSSEC properties",
+			"Could not read DNSSEC properties of zone "+zone+": "+err.Error(),
+		)
+		return
+	}
+
+	var key *technitium.DnssecPrivateKey
+	for i := range properties.DnssecPrivateKeys {
+		if properties.DnssecPrivateKeys[i].KeyTag == keyTag {
+			key = &properties.DnssecPrivateKeys[i]
+			break
+		}
+	}
+	if key == nil {
+		resp.Diagnostics.AddError(
+			"Error importing DNSSEC private key",
+			"Could not find DNSSEC private key "+strconv.FormatInt(keyTag, 10)+" in zone "+zone,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), zone)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key_tag"), keyTag)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key_type"), key.KeyType)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("publish"), key.State != "Generated")...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("retire"), key.IsRetiring)...)
+
+	// The generation parameters cannot be read back, so they are derived from the DNSKEY algorithm
+	algorithm, hashAlgorithm, curve, ok := dnssecKeyParameters(key.Algorithm)
+	if !ok {
+		resp.Diagnostics.AddWarning(
+			"Unknown DNSSEC algorithm",
+			"The generation parameters of algorithm "+key.Algorithm+" could not be determined and must be set manually.",
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("algorithm"), algorithm)...)
+	if hashAlgorithm != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hash_algorithm"), hashAlgorithm)...)
+	}
+	if curve != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("curve"), curve)...)
+	}
+}
+
+func (r *dnsZoneDnssecKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.RequiresReplace = path.Paths{
+		path.Root("zone"),
+		path.Root("key_type"),
+		path.Root("algorithm"),
+	}
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state dnsZoneDnssecKey
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The generation parameters cannot be read back, so an imported key without them is not replaced
+	if !state.HashAlgorithm.IsNull() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("hash_algorithm"))
+	}
+	if !state.KeySize.IsNull() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("key_size"))
+	}
+	if !state.Curve.IsNull() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("curve"))
+	}
+
+	if state.Publish.ValueBool() && !plan.Publish.IsUnknown() && !plan.Publish.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("publish"), "Cannot unpublish DNSSEC private key",
+			"The DNSKEY record of a published key cannot be withdrawn, retire the key instead.")
+		return
+	}
+
+	if req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	// Publishing and retiring change the key state, so it is only known after the update
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("state"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("state_changed_on"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("state_ready_by"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("is_retiring"), types.BoolUnknown())...)
+}
+
+// RefreshKey sets the values reported by the server for the key identified by the key tag.
+func (r *dnsZoneDnssecKeyResource) RefreshKey(ctx context.Context, data *dnsZoneDnssecKey) error {
+
+	properties, err := r.client.GetDnsZoneDnssecProperties(data.Zone.ValueString(), ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range properties.DnssecPrivateKeys {
+		if key.KeyTag != data.KeyTag.ValueInt64() {
+			continue
+		}
+		data.KeyType = types.StringValue(key.KeyType)
+		data.DnsKeyAlgorithm = types.StringValue(key.Algorithm)
+		data.RolloverDays = types.Int64Value(key.RolloverDays)
+		data.State = types.StringValue(key.State)
+		data.StateChangedOn = types.StringValue(key.StateChangedOn)
+		data.StateReadyBy = types.StringValue(key.StateReadyBy)
+		data.IsRetiring = types.BoolValue(key.IsRetiring)
+		return nil
+	}
+
+	return fmt.Errorf("%w: key %d in zone %s", errDnssecKeyNotFound, data.KeyTag.ValueInt64(), data.Zone.ValueString())
+}
+
+var errDnssecKeyNotFound = errors.New("DNSSEC private key not found")
+
+// isDnssecKeyNotFound reports whether the error is caused by the key or its zone no longer existing.
+func isDnssecKeyNotFound(err error) bool {
+	return errors.Is(err, errDnssecKeyNotFound) || technitium.IsZoneNotFound(err)
+}
+
+func findNewDnssecKeyTag(before []technitium.DnssecPrivateKey, after []technitium.DnssecPrivateKey, keyType string) (int64, bool) {
+
+	existing := make(map[int64]bool, len(before))
+	for _, key := range before {
+		existing[key.KeyTag] = true
+	}
+
+	for _, key := range after {
+		if key.KeyType == keyType && !existing[key.KeyTag] {
+			return key.KeyTag, true
+		}
+	}
+
+	return 0, false
+}
+
+func dnssecKeyParameters(dnsKeyAlgorithm string) (algorithm string, hashAlgorithm string, curve string, ok bool) {
+
+	switch technitium.CanonicalDnssecAlgorithm(dnsKeyAlgorithm) {
+	case "RSAMD5":
+		return "RSA", "MD5", "", true
+	case "RSASHA1", "RSASHA1-NSEC3-SHA1":
+		return "RSA", "SHA1", "", true
+	case "RSASHA256":
+		return "RSA", "SHA256", "", true
+	case "RSASHA512":
+		return "RSA", "SHA512", "", true
+	case "ECDSAP256SHA256":
+		return "ECDSA", "", "P256", true
+	case "ECDSAP384SHA384":
+		return "ECDSA", "", "P384", true
+	case "ED25519":
+		return "EDDSA", "", "ED25519", true
+	case "ED448":
+		return "EDDSA", "", "ED448", true
+	}
+
+	return "", "", "", false
+}
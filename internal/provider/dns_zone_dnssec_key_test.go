@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDnsZoneDnssecKey(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone_dnssec_key.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_dnssec.test", "zsk_rollover_days", "0"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_dnssec_key.test", "state", "Generated"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_dnssec_key.test", "dnskey_algorithm", "ECDSAP384SHA384"),
+					resource.TestCheckResourceAttrSet("technitium_dns_zone_dnssec_key.test", "key_tag"),
+				),
+			},
+			{
+				// Publishing the key must not generate a new one
+				Config: GetFileConfig(t, "dns_zone_dnssec_key_published.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone_dnssec_key.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_dnssec_key.test", "state", "Published"),
+				),
+			},
+			{
+				ResourceName:            "technitium_dns_zone_dnssec_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       importStateIdDnssecKey,
+				ImportStateVerifyIgnore: []string{"state_changed_on", "state_ready_by"},
+			},
+			{
+				// The rollover period of the zone does not override the period of the key
+				Config: GetFileConfig(t, "dns_zone_dnssec_key_rollover.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_dnssec.test", "zsk_rollover_days", "90"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_dnssec_key.test", "rollover_days", "60"),
+				),
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_dnssec_key_rollover.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config:      GetFileConfig(t, "dns_zone_dnssec_key.tf"),
+				ExpectError: regexp.MustCompile("Cannot unpublish DNSSEC private key"),
+			},
+		},
+	})
+}
+
+func importStateIdDnssecKey(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources["technitium_dns_zone_dnssec_key.test"]
+	if !ok {
+		return "", fmt.Errorf("resource not found in state")
+	}
+	return rs.Primary.Attributes["zone"] + "/" + rs.Primary.Attributes["key_tag"], nil
+}
+
+func TestIsDnssecKeyNotFound(t *testing.T) {
+	tests := []struct {
+		err      error
+		expected bool
+	}{
+		{fmt.Errorf("%w: key 12345 in zone example.com", errDnssecKeyNotFound), true},
+		{errors.New("failed to get DNSSEC properties: No such zone was found: example.com"), true},
+		{errors.New("failed to get DNSSEC properties: Invalid token or session expired."), false},
+		{errors.New("dial tcp 127.0.0.1:5380: connect: connection refused"), false},
+		{nil, false},
+	}
+
+	for _, tt := range tests {
+		if found := isDnssecKeyNotFound(tt.err); found != tt.expected {
+			t.Errorf("%v: expected %t, got %t", tt.err, tt.expected, found)
+		}
+	}
+}
+
+func TestDnssecKeyParameters(t *testing.T) {
+	tests := []struct {
+		dnsKeyAlgorithm string
+		algorithm       string
+		hashAlgorithm   string
+		curve           string
+	}{
+		{"RSASHA256", "RSA", "SHA256", ""},
+		{"RSASHA1_NSEC3_SHA1", "RSA", "SHA1", ""},
+		{"ECDSAP384SHA384", "ECDSA", "", "P384"},
+		{"ED25519", "EDDSA", "", "ED25519"},
+	}
+
+	for _, tt := range tests {
+		algorithm, hashAlgorithm, curve, ok := dnssecKeyParameters(tt.dnsKeyAlgorithm)
+		if !ok || algorithm != tt.algorithm || hashAlgorithm != tt.hashAlgorithm || curve != tt.curve {
+			t.Errorf("%s: expected %s %s %s, got %s %s %s (%t)", tt.dnsKeyAlgorithm, tt.algorithm, tt.hashAlgorithm, tt.curve, algorithm, hashAlgorithm, curve, ok)
+		}
+	}
+
+	if _, _, _, ok := dnssecKeyParameters("ECC_GOST"); ok {
+		t.Errorf("ECC_GOST: expected unknown parameters")
+	}
+}
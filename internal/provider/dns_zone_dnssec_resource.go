@@ -0,0 +1,503 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"terraform-provider-technitium/internal/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsZoneDnssecResource{}
+	_ resource.ResourceWithConfigure      = &dnsZoneDnssecResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsZoneDnssecResource{}
+	_ resource.ResourceWithValidateConfig = &dnsZoneDnssecResource{}
+)
+
+func NewDnsZoneDnssecResource() resource.Resource {
+	return &dnsZoneDnssecResource{}
+}
+
+type dnsZoneDnssecResource struct {
+	client *technitium.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsZoneDnssecResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = ConfigureResourceClient(req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *dnsZoneDnssecResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_dnssec"
+}
+
+func (r *dnsZoneDnssecResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes:  DnsZoneDnssecResourceSchema(),
+		Description: "Signs a primary zone with DNSSEC. Destroying this resource unsigns the zone.",
+	}
+}
+
+// ValidateConfig checks that the key parameters match the selected algorithm.
+func (r *dnsZoneDnssecResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config dnsZoneDnssec
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Algorithm.IsUnknown() || config.Algorithm.IsNull() {
+		return
+	}
+
+	switch config.Algorithm.ValueString() {
+	case "RSA":
+		if config.HashAlgorithm.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("hash_algorithm"), "Missing hash algorithm", "`hash_algorithm` is required when `algorithm` is `RSA`.")
+		}
+		if config.KskKeySize.IsNull() || config.ZskKeySize.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("algorithm"), "Missing key size", "`ksk_key_size` and `zsk_key_size` are required when `algorithm` is `RSA`.")
+		}
+		if !config.Curve.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("curve"), "Invalid attribute", "`curve` cannot be used when `algorithm` is `RSA`.")
+		}
+	case "ECDSA", "EDDSA":
+		if config.Curve.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("curve"), "Missing curve", "`curve` is required when `algorithm` is `"+config.Algorithm.ValueString()+"`.")
+		}
+		if !config.HashAlgorithm.IsNull() || !config.KskKeySize.IsNull() || !config.ZskKeySize.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("algorithm"), "Invalid attribute", "`hash_algorithm`, `ksk_key_size` and `zsk_key_size` can only be used when `algorithm` is `RSA`.")
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("algorithm"), "Invalid algorithm", "Valid values are `RSA`, `ECDSA` and `EDDSA`, got `"+config.Algorithm.ValueString()+"`.")
+	}
+
+	if !config.NxProof.IsUnknown() && !config.NxProof.IsNull() && config.NxProof.ValueString() != "NSEC" && config.NxProof.ValueString() != "NSEC3" {
+		resp.Diagnostics.AddAttributeError(path.Root("nx_proof"), "Invalid proof of non-existence", "Valid values are `NSEC` and `NSEC3`, got `"+config.NxProof.ValueString()+"`.")
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsZoneDnssecResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	var plan dnsZoneDnssec
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sign := technitium.DnsZoneDnssecSign{
+		Zone:            plan.Zone.ValueString(),
+		Algorithm:       plan.Algorithm.ValueString(),
+		HashAlgorithm:   plan.HashAlgorithm.ValueString(),
+		KskKeySize:      plan.KskKeySize.ValueInt64(),
+		ZskKeySize:      plan.ZskKeySize.ValueInt64(),
+		Curve:           plan.Curve.ValueString(),
+		DnsKeyTTL:       plan.DnsKeyTTL.ValueInt64(),
+		ZskRolloverDays: plan.ZskRolloverDays.ValueInt64(),
+		NxProof:         plan.NxProof.ValueString(),
+		Iterations:      plan.Iterations.ValueInt64(),
+		SaltLength:      plan.SaltLength.ValueInt64(),
+	}
+
+	err := r.client.SignDnsZone(sign, ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error signing DNS zone",
+			"Could not sign DNS zone "+plan.Zone.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// A rollover period of 0 is not sent when signing, so the generated keys are aligned afterwards
+	err = r.UpdateZskRolloverDays(ctx, plan.Zone.ValueString(), nil, plan.ZskRolloverDays.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating DNSSEC private keys",
+			"Could not update the Zone Signing Keys of zone "+plan.Zone.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.RefreshDnssec(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsZoneDnssecResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	var plan dnsZoneDnssec
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state dnsZoneDnssec
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.UpdateDnssec(ctx, state, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating DNSSEC properties",
+			"Could not update DNSSEC properties of zone "+plan.Zone.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.RefreshDnssec(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *dnsZoneDnssecResource) UpdateDnssec(ctx context.Context, state dnsZoneDnssec, plan dnsZoneDnssec) error {
+
+	zone := plan.Zone.ValueString()
+	iterations := plan.Iterations.ValueInt64()
+	saltLength := plan.SaltLength.ValueInt64()
+
+	switch {
+	case plan.NxProof.ValueString() == "NSEC3" && state.NxProof.ValueString() != "NSEC3":
+		err := r.client.ConvertDnsZoneToNsec3(zone, iterations, saltLength, ctx)
+		if err != nil {
+			return err
+		}
+	case plan.NxProof.ValueString() != "NSEC3" && state.NxProof.ValueString() == "NSEC3":
+		err := r.client.ConvertDnsZoneToNsec(zone, ctx)
+		if err != nil {
+			return err
+		}
+	case plan.NxProof.ValueString() == "NSEC3" && (iterations != state.Iterations.ValueInt64() || saltLength != state.SaltLength.ValueInt64()):
+		err := r.client.UpdateDnsZoneNsec3Parameters(zone, iterations, saltLength, ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if plan.DnsKeyTTL.ValueInt64() != state.DnsKeyTTL.ValueInt64() {
+		err := r.client.UpdateDnsZoneDnsKeyTTL(zone, plan.DnsKeyTTL.ValueInt64(), ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if plan.ZskRolloverDays.ValueInt64() != state.ZskRolloverDays.ValueInt64() {
+		previous := state.ZskRolloverDays.ValueInt64()
+		err := r.UpdateZskRolloverDays(ctx, zone, &previous, plan.ZskRolloverDays.ValueInt64())
+		if err != nil {
+			return err
+		}
+	}
+
+	if !plan.KskRolloverTrigger.IsNull() && !plan.KskRolloverTrigger.Equal(state.KskRolloverTrigger) {
+		err := r.RolloverActiveKeys(ctx, zone, "KeySigningKey")
+		if err != nil {
+			return err
+		}
+	}
+
+	if !plan.ZskRolloverTrigger.IsNull() && !plan.ZskRolloverTrigger.Equal(state.ZskRolloverTrigger) {
+		err := r.RolloverActiveKeys(ctx, zone, "ZoneSigningKey")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateZskRolloverDays sets the automatic rollover period of the Zone Signing Keys that are not being retired. With a
+// previous period, only the keys that still have it are updated, so that keys given their own period by
+// technitium_dns_zone_dnssec_key are left unchanged.
+func (r *dnsZoneDnssecResource) UpdateZskRolloverDays(ctx context.Context, zone string, previous *int64, rolloverDays int64) error {
+
+	properties, err := r.client.GetDnsZoneDnssecProperties(zone, ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range properties.DnssecPrivateKeys {
+		if key.KeyType != "ZoneSigningKey" || key.IsRetiring || key.RolloverDays == rolloverDays {
+			continue
+		}
+		if previous != nil && key.RolloverDays != *previous {
+			continue
+		}
+		err = r.client.UpdateDnssecPrivateKey(zone, key.KeyTag, rolloverDays, ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RolloverActiveKeys rolls over every active key of the given type.
+func (r *dnsZoneDnssecResource) RolloverActiveKeys(ctx context.Context, zone string, keyType string) error {
+
+	properties, err := r.client.GetDnsZoneDnssecProperties(zone, ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range properties.DnssecPrivateKeys {
+		if key.KeyType != keyType || key.State != "Active" || key.IsRetiring {
+			continue
+		}
+		tflog.Info(ctx, fmt.Sprintf("Rolling over %s %d of zone %s", keyType, key.KeyTag, zone))
+		err = r.client.RolloverDnsKey(zone, key.KeyTag, ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RefreshDnssec sets the values reported by the server for the DNSSEC properties and DS records.
+func (r *dnsZoneDnssecResource) RefreshDnssec(ctx context.Context, data *dnsZoneDnssec) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+
+	properties, err := r.client.GetDnsZoneDnssecProperties(data.Zone.ValueString(), ctx)
+	if err != nil {
+		diags.AddError(
+			"Error reading DNSSEC properties",
+			"Could not read DNSSEC properties of zone "+data.Zone.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	dsRecords, err := r.client.GetDnsZoneDsRecords(data.Zone.ValueString(), ctx)
+	if err != nil {
+		diags.AddError(
+			"Error reading DS records",
+			"Could not read DS records of zone "+data.Zone.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	setDnssecState(data, properties)
+
+	records, d := convertDsRecordsToListValue(ctx, dsRecords)
+	diags.Append(d...)
+	data.DsRecords = records
+
+	keys, d := convertDnssecPrivateKeysToListValue(ctx, properties.DnssecPrivateKeys)
+	diags.Append(d...)
+	data.PrivateKeys = keys
+
+	return diags
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsZoneDnssecResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	var state dnsZoneDnssec
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	properties, err := r.client.GetDnsZoneDnssecProperties(state.Zone.ValueString(), ctx)
+	if technitium.IsZoneNotFound(err) {
+		tflog.Info(ctx, "Removing DNSSEC for zone "+state.Zone.ValueString()+" from state: "+err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNSSEC properties",
+			"Could not read DNSSEC properties of zone "+state.Zone.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if properties.DnssecStatus == "Unsigned" {
+		tflog.Info(ctx, "Removing DNSSEC for zone "+state.Zone.ValueString()+" from state, the zone is not signed")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	dsRecords, err := r.client.GetDnsZoneDsRecords(state.Zone.ValueString(), ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DS records",
+			"Could not read DS records of zone "+state.Zone.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	setDnssecState(&state, properties)
+
+	records, diags := convertDsRecordsToListValue(ctx, dsRecords)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.DsRecords = records
+
+	keys, diags := convertDnssecPrivateKeysToListValue(ctx, properties.DnssecPrivateKeys)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.PrivateKeys = keys
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsZoneDnssecResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	var state dnsZoneDnssec
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UnsignDnsZone(state.Zone.ValueString(), ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error unsigning DNS zone",
+			"Could not unsign DNS zone "+state.Zone.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *dnsZoneDnssecResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.RequiresReplace = path.Paths{
+		path.Root("zone"),
+		path.Root("algorithm"),
+		path.Root("hash_algorithm"),
+		path.Root("ksk_key_size"),
+		path.Root("zsk_key_size"),
+		path.Root("curve"),
+	}
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The DNSSEC status reflects the proof of non-existence, so it is only known after a conversion
+	var plan, state dnsZoneDnssec
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.NxProof.Equal(state.NxProof) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("dnssec_status"), types.StringUnknown())...)
+	}
+
+	// Rollovers and rollover period changes are reflected in the private keys and DS records
+	if !plan.ZskRolloverDays.Equal(state.ZskRolloverDays) || !plan.KskRolloverTrigger.Equal(state.KskRolloverTrigger) || !plan.ZskRolloverTrigger.Equal(state.ZskRolloverTrigger) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("private_keys"), types.ListUnknown(types.ObjectType{AttrTypes: dnsZoneDnssecPrivateKeyAttrTypes}))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ds_records"), types.ListUnknown(types.ObjectType{AttrTypes: dnsZoneDsRecordAttrTypes}))...)
+	}
+}
+
+func setDnssecState(data *dnsZoneDnssec, properties technitium.DnsZoneDnssecProperties) {
+	data.DnssecStatus = types.StringValue(properties.DnssecStatus)
+	data.DnsKeyTTL = types.Int64Value(properties.DnsKeyTTL)
+
+	// Keys managed by technitium_dns_zone_dnssec_key can have their own rollover period, so the period is only
+	// refreshed when no Zone Signing Key has the current one
+	var rolloverDays []int64
+	for _, key := range properties.DnssecPrivateKeys {
+		if key.KeyType == "ZoneSigningKey" && !key.IsRetiring {
+			rolloverDays = append(rolloverDays, key.RolloverDays)
+		}
+	}
+	if len(rolloverDays) > 0 && (data.ZskRolloverDays.IsNull() || !slices.Contains(rolloverDays, data.ZskRolloverDays.ValueInt64())) {
+		data.ZskRolloverDays = types.Int64Value(rolloverDays[0])
+	}
+
+	// The NSEC3 parameters are only reported for a zone signed with NSEC3
+	if properties.DnssecStatus == "SignedWithNSEC3" {
+		data.NxProof = types.StringValue("NSEC3")
+		data.Iterations = types.Int64Value(properties.Nsec3Iterations)
+		data.SaltLength = types.Int64Value(properties.Nsec3SaltLength)
+	} else {
+		data.NxProof = types.StringValue("NSEC")
+	}
+}
+
+func convertDsRecordsToListValue(ctx context.Context, dsRecords []technitium.DsRecord) (types.List, diag.Diagnostics) {
+
+	records := make([]dnsZoneDsRecord, 0)
+	for _, ds := range dsRecords {
+		for _, digest := range ds.Digests {
+			records = append(records, dnsZoneDsRecord{
+				KeyTag:           types.Int64Value(ds.KeyTag),
+				DnsKeyState:      types.StringValue(ds.DnsKeyState),
+				Algorithm:        types.StringValue(ds.Algorithm),
+				AlgorithmNumber:  types.Int64Value(technitium.DnssecAlgorithmNumbers[ds.Algorithm]),
+				DigestType:       types.StringValue(digest.DigestType),
+				DigestTypeNumber: types.Int64Value(technitium.DsDigestTypeNumbers[digest.DigestType]),
+				Digest:           types.StringValue(digest.Digest),
+			})
+		}
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dnsZoneDsRecordAttrTypes}, records)
+}
+
+func convertDnssecPrivateKeysToListValue(ctx context.Context, privateKeys []technitium.DnssecPrivateKey) (types.List, diag.Diagnostics) {
+
+	keys := make([]dnsZoneDnssecPrivateKey, 0, len(privateKeys))
+	for _, key := range privateKeys {
+		keys = append(keys, dnsZoneDnssecPrivateKey{
+			KeyTag:         types.Int64Value(key.KeyTag),
+			KeyType:        types.StringValue(key.KeyType),
+			Algorithm:      types.StringValue(key.Algorithm),
+			State:          types.StringValue(key.State),
+			StateChangedOn: types.StringValue(key.StateChangedOn),
+			StateReadyBy:   types.StringValue(key.StateReadyBy),
+			IsRetiring:     types.BoolValue(key.IsRetiring),
+			RolloverDays:   types.Int64Value(key.RolloverDays),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: dnsZoneDnssecPrivateKeyAttrTypes}, keys)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"terraform-provider-technitium/internal/technitium"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccDnsZoneDnssec(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone_dnssec.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_dnssec.test", "dnssec_status", "SignedWithNSEC"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_dnssec.test", "ds_records.0.algorithm_number", "13"),
+					resource.TestCheckResourceAttrSet("technitium_dns_zone_dnssec.test", "ds_records.0.digest"),
+				),
+			},
+			{
+				// Switching to NSEC3 and changing the DNSKEY TTL must not re-sign the zone
+				Config: GetFileConfig(t, "dns_zone_dnssec_nsec3.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone_dnssec.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_dnssec.test", "dnssec_status", "SignedWithNSEC3"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_dnssec.test", "dns_key_ttl", "7200"),
+				),
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_dnssec_nsec3.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestSetDnssecState(t *testing.T) {
+	data := dnsZoneDnssec{
+		NxProof:    types.StringValue("NSEC3"),
+		Iterations: types.Int64Value(0),
+		SaltLength: types.Int64Value(0),
+	}
+
+	// NSEC3 parameters changed outside Terraform are refreshed
+	setDnssecState(&data, technitium.DnsZoneDnssecProperties{
+		DnssecStatus:    "SignedWithNSEC3",
+		Nsec3Iterations: 5,
+		Nsec3SaltLength: 8,
+		DnsKeyTTL:       3600,
+	})
+
+	if data.NxProof.ValueString() != "NSEC3" || data.Iterations.ValueInt64() != 5 || data.SaltLength.ValueInt64() != 8 {
+		t.Errorf("Unexpected NSEC3 state %s %s %s", data.NxProof, data.Iterations, data.SaltLength)
+	}
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"terraform-provider-technitium/internal/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dnsZoneExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsZoneExportDataSource{}
+)
+
+func NewDnsZoneExportDataSource() datasource.DataSource {
+	return &dnsZoneExportDataSource{}
+}
+
+type dnsZoneExportDataSource struct {
+	client *technitium.Client
+}
+
+// Metadata returns the data source type name.
+func (d *dnsZoneExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_export"
+}
+
+// Schema defines the schema for the data source.
+func (d *dnsZoneExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes:  DnsZoneExportSchema(),
+		Description: "Exports all records of a zone as zone file text.",
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dnsZoneExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var state dnsZoneExport
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	content, err := d.client.ExportDnsZone(state.Zone.ValueString(), ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Export DNS Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Content = types.StringValue(content)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *dnsZoneExportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = ConfigureDataSourceClient(req, resp)
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDnsZoneExportDataSource(t *testing.T) {
+
+	export, err := os.ReadFile("../test/mocks/dns_zone_export_response.txt")
+	if err != nil {
+		t.Fatalf("failed to read mock file: %v", err)
+	}
+
+	// The export is plain text, so the session check needs its own JSON response
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/zones/export" {
+			fmt.Fprint(w, string(export))
+			return
+		}
+		fmt.Fprint(w, `{"status":"ok"}`)
+	}))
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "technitium" {
+  host = "%s"
+  token = "test"
+}
+`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config + `data "technitium_dns_zone_export" "test" { zone = "example.com" }`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.technitium_dns_zone_export.test", "zone", "example.com"),
+					resource.TestCheckResourceAttr("data.technitium_dns_zone_export.test", "content", string(export)),
+					resource.TestMatchResourceAttr("data.technitium_dns_zone_export.test", "content", regexp.MustCompile(`(?m)^www\.example\.com\.\s+3600\s+IN\s+A\s+192\.0\.2\.1$`)),
+				),
+			},
+		},
+	})
+}
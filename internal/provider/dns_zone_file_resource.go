@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"terraform-provider-technitium/internal/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsZoneFileResource{}
+	_ resource.ResourceWithConfigure      = &dnsZoneFileResource{}
+	_ resource.ResourceWithImportState    = &dnsZoneFileResource{}
+	_ resource.ResourceWithValidateConfig = &dnsZoneFileResource{}
+)
+
+func NewDnsZoneFileResource() resource.Resource {
+	return &dnsZoneFileResource{}
+}
+
+type dnsZoneFileResource struct {
+	client *technitium.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsZoneFileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = ConfigureResourceClient(req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *dnsZoneFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_file"
+}
+
+func (r *dnsZoneFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: DnsZoneFileResourceSchema(),
+		Description: "Imports records from an RFC 1035 zone file into an existing zone. The zone is compared with an export on refresh " +
+			"and the records are imported again when any of them is missing or changed. " +
+			"Destroying this resource only removes it from the Terraform state, the imported records are kept.",
+	}
+}
+
+// ValidateConfig checks that the zone file can be parsed.
+func (r *dnsZoneFileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config dnsZoneFile
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Zone.IsUnknown() || config.Content.IsUnknown() {
+		return
+	}
+
+	_, err := technitium.ParseZoneFile(config.Content.ValueString(), config.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("content"), "Invalid zone file", err.Error())
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsZoneFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	var plan dnsZoneFile
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ImportDnsZone(plan.Zone.ValueString(), plan.Content.ValueString(), plan.Overwrite.ValueBool(), plan.OverwriteSoaSerial.ValueBool(), ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing zone file",
+			"Could not import zone file into zone "+plan.Zone.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsZoneFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	var state dnsZoneFile
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exported, err := r.client.ExportDnsZone(state.Zone.ValueString(), ctx)
+	if err != nil {
+		tflog.Info(ctx, "Removing zone file from state due to error: "+err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.Content.IsNull() {
+		state.Content = types.StringValue(exported)
+	} else {
+		inSync, err := zoneFileInSync(state.Content.ValueString(), exported, state.Zone.ValueString(), state.Overwrite.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error comparing zone file",
+				"Could not compare zone file with zone "+state.Zone.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if !inSync {
+			tflog.Info(ctx, "Zone "+state.Zone.ValueString()+" differs from the imported zone file")
+			state.Content = types.StringValue(exported)
+		}
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsZoneFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	var plan dnsZoneFile
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ImportDnsZone(plan.Zone.ValueString(), plan.Content.ValueString(), plan.Overwrite.ValueBool(), plan.OverwriteSoaSerial.ValueBool(), ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing zone file",
+			"Could not import zone file into zone "+plan.Zone.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state, the imported records are kept.
+func (r *dnsZoneFileResource) Delete(ctx context.Context, req resource.DeleteRequest, _ *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing zone file from state, the imported records are kept")
+}
+
+func (r *dnsZoneFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("zone"), req, resp)
+
+	// Imports are not replayed, so the defaults are set to keep the first plan from importing the zone file again
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("overwrite"), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("overwrite_soa_serial"), false)...)
+}
+
+// zoneFileInSync reports whether every record of the zone file is present in the exported zone. With overwrite, the
+// record sets of the zone file must also not contain any other record. The SOA record is ignored as its serial changes.
+func zoneFileInSync(content string, exported string, zone string, overwrite bool) (bool, error) {
+
+	records, err := technitium.ParseZoneFile(content, zone)
+	if err != nil {
+		return false, err
+	}
+
+	current, err := technitium.ParseZoneFile(exported, zone)
+	if err != nil {
+		return false, err
+	}
+
+	currentSets := make(map[string]map[string]bool)
+	for _, record := range current {
+		set := strings.ToLower(record.Name) + " " + record.Type
+		if currentSets[set] == nil {
+			currentSets[set] = make(map[string]bool)
+		}
+		currentSets[set][record.Key()] = true
+	}
+
+	wantedSets := make(map[string]map[string]bool)
+	for _, record := range records {
+		if record.Type == "SOA" {
+			continue
+		}
+		set := strings.ToLower(record.Name) + " " + record.Type
+		if wantedSets[set] == nil {
+			wantedSets[set] = make(map[string]bool)
+		}
+		wantedSets[set][record.Key()] = true
+	}
+
+	for set, wanted := range wantedSets {
+		for key := range wanted {
+			if !currentSets[set][key] {
+				return false, nil
+			}
+		}
+		if overwrite && len(currentSets[set]) != len(wanted) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDnsZoneFile(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone_file.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_file.test", "overwrite", "true"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_file.test", "overwrite_soa_serial", "false"),
+				),
+			},
+			{
+				// The imported records must match the export of the zone
+				Config: GetFileConfig(t, "dns_zone_file.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_file_updated.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone_file.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				// The defaults are set on import so that the first plan does not import the zone file again
+				ResourceName:  "technitium_dns_zone_file.test",
+				ImportState:   true,
+				ImportStateId: "example.com",
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					for _, attribute := range []string{"overwrite", "overwrite_soa_serial"} {
+						if value := states[0].Attributes[attribute]; value != "false" {
+							return fmt.Errorf("expected %s to be false, got %q", attribute, value)
+						}
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestZoneFileInSync(t *testing.T) {
+	content := `$TTL 3600
+@	IN	SOA	ns1 hostadmin 1 900 300 604800 900
+www	IN	AAAA	2001:db8::1
+txt	IN	TXT	"v=spf1 -all"
+`
+	exported := `$ORIGIN example.com.
+@	3600	IN	SOA	ns1.example.com. hostadmin.example.com. 7 900 300 604800 900
+www	3600	IN	AAAA	2001:0db8:0:0:0:0:0:1
+txt	3600	IN	TXT	"v=spf1" " -all"
+`
+
+	inSync, err := zoneFileInSync(content, exported, "example.com", true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !inSync {
+		t.Error("Expected records only differing in formatting to be in sync")
+	}
+
+	inSync, err = zoneFileInSync(content, exported+"www\t3600\tIN\tAAAA\t2001:db8::2\n", "example.com", true)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if inSync {
+		t.Error("Expected an additional record to be out of sync with overwrite")
+	}
+}
@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"slices"
+	"strings"
+	"terraform-provider-technitium/internal/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dnsZoneOptionsResource{}
+	_ resource.ResourceWithConfigure   = &dnsZoneOptionsResource{}
+	_ resource.ResourceWithImportState = &dnsZoneOptionsResource{}
+)
+
+func NewDnsZoneOptionsResource() resource.Resource {
+	return &dnsZoneOptionsResource{}
+}
+
+type dnsZoneOptionsResource struct {
+	client *technitium.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsZoneOptionsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = ConfigureResourceClient(req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *dnsZoneOptionsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_options"
+}
+
+func (r *dnsZoneOptionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: DnsZoneOptionsResourceSchema(),
+		Description: "Manages the query access, zone transfer, notify and dynamic update settings of a zone. " +
+			"Lists that are not configured are cleared on the server. " +
+			"Destroying this resource only removes it from the Terraform state, the zone keeps its last settings.",
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsZoneOptionsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	var plan dnsZoneOptions
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.SetZoneOptions(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsZoneOptionsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	var plan dnsZoneOptions
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.SetZoneOptions(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// SetZoneOptions sends the planned options to the server and fills in the settings
+// that are not configured with the values the server reports back.
+func (r *dnsZoneOptionsResource) SetZoneOptions(ctx context.Context, plan *dnsZoneOptions) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	options := technitium.DnsZoneOptions{
+		Zone:         plan.Zone.ValueString(),
+		QueryAccess:  plan.QueryAccess.ValueString(),
+		ZoneTransfer: plan.ZoneTransfer.ValueString(),
+		Notify:       plan.Notify.ValueString(),
+		Update:       plan.Update.ValueString(),
+	}
+
+	options.QueryAccessNetworkAcl, d = convertListValueToStringList(ctx, plan.QueryAccessNetworkAcl)
+	diags.Append(d...)
+	options.ZoneTransferNetworkAcl, d = convertListValueToStringList(ctx, plan.ZoneTransferNetworkAcl)
+	diags.Append(d...)
+	options.ZoneTransferTsigKeyNames, d = convertListValueToStringList(ctx, plan.ZoneTransferTsigKeyNames)
+	diags.Append(d...)
+	options.NotifyNameServers, d = convertListValueToStringList(ctx, plan.NotifyNameServers)
+	diags.Append(d...)
+	options.UpdateNetworkAcl, d = convertListValueToStringList(ctx, plan.UpdateNetworkAcl)
+	diags.Append(d...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	err := r.client.SetDnsZoneOptions(options, ctx)
+	if err != nil {
+		diags.AddError(
+			"Error setting DNS zone options",
+			"Could not set options for DNS zone "+plan.Zone.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	zone, err := r.client.GetDnsZone(plan.Zone.ValueString(), ctx)
+	if err != nil {
+		diags.AddError(
+			"Error reading DNS zone options",
+			"Could not read options for DNS zone "+plan.Zone.ValueString()+": "+err.Error(),
+		)
+		return diags
+	}
+
+	// The configured values are kept as planned, the server may report them in another form
+	setUnknownString(&plan.QueryAccess, zone.QueryAccess)
+	setUnknownString(&plan.ZoneTransfer, zone.ZoneTransfer)
+	setUnknownString(&plan.Notify, zone.Notify)
+	setUnknownString(&plan.Update, zone.Update)
+
+	return diags
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsZoneOptionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	var state dnsZoneOptions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := r.client.GetDnsZone(state.Zone.ValueString(), ctx)
+	if technitium.IsZoneNotFound(err) {
+		tflog.Info(ctx, "Removing options for zone "+state.Zone.ValueString()+" from state due to error: "+err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS zone options",
+			"Could not read options of DNS zone "+state.Zone.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setZoneOptionsState(ctx, &state, zone)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state. The zone options cannot be
+// deleted, so the zone keeps the settings it had when the resource was destroyed.
+func (r *dnsZoneOptionsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	var state dnsZoneOptions
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Removing options for zone "+state.Zone.ValueString()+" from state, the zone keeps its current settings")
+}
+
+// ImportState imports the options of an existing zone using the zone name as the identifier.
+func (r *dnsZoneOptionsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("zone"), req, resp)
+}
+
+func setZoneOptionsState(ctx context.Context, state *dnsZoneOptions, zone technitium.DnsZone) diag.Diagnostics {
+
+	var diags diag.Diagnostics
+
+	state.QueryAccess = types.StringValue(zone.QueryAccess)
+	state.ZoneTransfer = types.StringValue(zone.ZoneTransfer)
+	state.Notify = types.StringValue(zone.Notify)
+	state.Update = types.StringValue(zone.Update)
+
+	diags.Append(setZoneOptionsList(ctx, &state.QueryAccessNetworkAcl, zone.QueryAccessNetworkAcl)...)
+	diags.Append(setZoneOptionsList(ctx, &state.ZoneTransferNetworkAcl, zone.ZoneTransferNetworkAcl)...)
+	diags.Append(setZoneOptionsList(ctx, &state.ZoneTransferTsigKeyNames, zone.ZoneTransferTsigKeyNames)...)
+	diags.Append(setZoneOptionsList(ctx, &state.NotifyNameServers, zone.NotifyNameServers)...)
+	diags.Append(setZoneOptionsList(ctx, &state.UpdateNetworkAcl, zone.UpdateNetworkAcl)...)
+
+	return diags
+}
+
+// setZoneOptionsList refreshes a list unless it has the same entries in another order or notation, as the server may
+// sort them and writes a single address network as the address.
+func setZoneOptionsList(ctx context.Context, target *types.List, items []string) diag.Diagnostics {
+
+	current, diags := convertListValueToStringList(ctx, *target)
+	if diags.HasError() {
+		return diags
+	}
+
+	if current != nil && len(current) == len(items) {
+		normalizedCurrent := make([]string, 0, len(current))
+		for _, entry := range current {
+			normalizedCurrent = append(normalizedCurrent, normalizeZoneOptionsEntry(entry))
+		}
+		normalizedItems := make([]string, 0, len(items))
+		for _, entry := range items {
+			normalizedItems = append(normalizedItems, normalizeZoneOptionsEntry(entry))
+		}
+		slices.Sort(normalizedCurrent)
+		slices.Sort(normalizedItems)
+		if slices.Equal(normalizedCurrent, normalizedItems) {
+			return diags
+		}
+	}
+
+	*target, diags = convertStringListToListValue(ctx, items)
+	return diags
+}
+
+// normalizeZoneOptionsEntry returns an address, network or name of a zone options list in a single notation. The `!`
+// prefix of a denied network ACL entry is kept.
+func normalizeZoneOptionsEntry(entry string) string {
+
+	entry = strings.ToLower(strings.TrimSpace(entry))
+	deny := strings.HasPrefix(entry, "!")
+	address := strings.TrimSpace(strings.TrimPrefix(entry, "!"))
+
+	if ip, network, err := net.ParseCIDR(address); err == nil {
+		ones, bits := network.Mask.Size()
+		if ones == bits {
+			address = ip.String()
+		} else {
+			address = network.String()
+		}
+	} else if ip := net.ParseIP(address); ip != nil {
+		address = ip.String()
+	}
+
+	if deny {
+		return "!" + address
+	}
+	return address
+}
+
+// setUnknownString sets an attribute that was not configured to the value reported by the server.
+func setUnknownString(target *types.String, value string) {
+	if target.IsUnknown() {
+		*target = types.StringValue(value)
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"terraform-provider-technitium/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccDnsZoneOptions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone_options.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_options.test", "zone_transfer", "UseSpecifiedNetworkACL"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_options.test", "zone_transfer_network_acl.0", "192.168.10.0/24"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_options.test", "notify_name_servers.0", "192.168.10.2"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_options.test", "query_access", "Allow"),
+				),
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_options.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:                         "technitium_dns_zone_options.test",
+				ImportState:                          true,
+				ImportStateId:                        "example.com",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone",
+			},
+			{
+				// Removing a list from the configuration clears it on the server
+				Config: GetFileConfig(t, "dns_zone_options_cleared.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_options.test", "notify_name_servers.#", "0"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_options.test", "zone_transfer_network_acl.#", "2"),
+				),
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_options_cleared.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestDnsZoneOptions_readError(t *testing.T) {
+
+	server := test.NewTestServer(test.Scenario{
+		ExpectedStatus: http.StatusOK,
+		ExpectedBody:   `{"status":"error","errorMessage":"The server is busy"}`,
+	})
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "technitium" {
+  host = "%s"
+  token = "test"
+}
+
+resource "technitium_dns_zone_options" "test" {
+  zone = "example.com"
+}
+`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Errors other than a missing zone are reported instead of planning to apply the options again
+				Config:        config,
+				ResourceName:  "technitium_dns_zone_options.test",
+				ImportState:   true,
+				ImportStateId: "example.com",
+				ExpectError:   regexp.MustCompile("Could not read options of DNS zone example.com"),
+			},
+		},
+	})
+}
+
+func TestNormalizeZoneOptionsEntry(t *testing.T) {
+	tests := []struct {
+		entry    string
+		expected string
+	}{
+		{"192.168.10.0/24", "192.168.10.0/24"},
+		{"192.168.10.1/32", "192.168.10.1"},
+		{"! 192.168.10.1", "!192.168.10.1"},
+		{"2001:DB8::1/128", "2001:db8::1"},
+		{"Key.Example.com", "key.example.com"},
+	}
+
+	for _, tt := range tests {
+		if normalized := normalizeZoneOptionsEntry(tt.entry); normalized != tt.expected {
+			t.Errorf("%q: expected %q, got %q", tt.entry, tt.expected, normalized)
+		}
+	}
+}
@@ -2,8 +2,14 @@ package provider
 
 import (
 	"context"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
 	"terraform-provider-technitium/internal/technitium"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -14,9 +20,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource               = &dnsZoneRecordResource{}
-	_ resource.ResourceWithConfigure  = &dnsZoneRecordResource{}
-	_ resource.ResourceWithModifyPlan = &dnsZoneRecordResource{}
+	_ resource.Resource                   = &dnsZoneRecordResource{}
+	_ resource.ResourceWithConfigure      = &dnsZoneRecordResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsZoneRecordResource{}
+	_ resource.ResourceWithValidateConfig = &dnsZoneRecordResource{}
+	_ resource.ResourceWithImportState    = &dnsZoneRecordResource{}
 )
 
 func NewDnsZoneRecordResource() resource.Resource {
@@ -44,6 +52,38 @@ func (r *dnsZoneRecordResource) Schema(_ context.Context, _ resource.SchemaReque
 
 }
 
+// ValidateConfig checks the record data attributes of the record type.
+func (r *dnsZoneRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config dnsZoneRecordCreate
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsUnknown() {
+		return
+	}
+
+	switch config.Type.ValueString() {
+	case "CAA":
+		if config.Tag.IsNull() || config.Value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("tag"), "Missing CAA property", "`tag` and `value` are required when `type` is `CAA`.")
+		}
+		if !config.Tag.IsUnknown() && !config.Tag.IsNull() {
+			switch config.Tag.ValueString() {
+			case "issue", "issuewild", "iodef":
+			default:
+				resp.Diagnostics.AddAttributeError(path.Root("tag"), "Invalid CAA tag", "Valid values are `issue`, `issuewild` and `iodef`, got `"+config.Tag.ValueString()+"`.")
+			}
+		}
+		if !config.Flags.IsUnknown() && (config.Flags.ValueInt64() < 0 || config.Flags.ValueInt64() > 255) {
+			resp.Diagnostics.AddAttributeError(path.Root("flags"), "Invalid CAA flags", "`flags` must be between 0 and 255.")
+		}
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *dnsZoneRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 
@@ -63,7 +103,7 @@ func (r *dnsZoneRecordResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	newRecord, err := r.client.GetDnsZoneRecord(plan.Domain.ValueString(), plan.Type.ValueString(), ctx)
+	newRecord, err := r.client.GetDnsZoneRecordByValue(plan.Domain.ValueString(), plan.Type.ValueString(), zoneRecordValue(plan), ctx)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -75,6 +115,7 @@ func (r *dnsZoneRecordResource) Create(ctx context.Context, req resource.CreateR
 
 	// Set the state with the new record data
 	plan.TTL = types.Int64Value(newRecord.TTL)
+	plan.ID = types.StringValue(zoneRecordID(plan))
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -100,6 +141,8 @@ func (r *dnsZoneRecordResource) CreateZoneRecord(plan dnsZoneRecordCreate, ctx c
 	record.UpdateSvcbHints = plan.UpdateSvcbHints.ValueBool()
 	record.NameServer = plan.NameServer.ValueString()
 	record.Cname = plan.Cname.ValueString()
+	record.Aname = plan.Aname.ValueString()
+	record.Dname = plan.Dname.ValueString()
 	record.PtrName = plan.PtrName.ValueString()
 	record.Exchange = plan.Exchange.ValueString()
 	record.Preference = plan.Preference.ValueInt64()
@@ -117,6 +160,36 @@ func (r *dnsZoneRecordResource) CreateZoneRecord(plan dnsZoneRecordCreate, ctx c
 	record.AppName = plan.AppName.ValueString()
 	record.ClassPath = plan.ClassPath.ValueString()
 	record.RecordData = plan.RecordData.ValueString()
+	record.Priority = plan.Priority.ValueInt64()
+	record.Weight = plan.Weight.ValueInt64()
+	record.Port = plan.Port.ValueInt64()
+	record.Target = plan.Target.ValueString()
+	record.Flags = plan.Flags.ValueInt64()
+	record.Tag = plan.Tag.ValueString()
+	record.Value = plan.Value.ValueString()
+	record.SvcPriority = plan.SvcPriority.ValueInt64()
+	record.SvcTargetName = plan.SvcTargetName.ValueString()
+	record.SvcParams = convertMapValueToStringMap(plan.SvcParams)
+	record.KeyTag = plan.KeyTag.ValueInt64()
+	record.Algorithm = plan.Algorithm.ValueString()
+	record.DigestType = plan.DigestType.ValueString()
+	record.Digest = plan.Digest.ValueString()
+	record.SshfpAlgorithm = plan.SshfpAlgorithm.ValueString()
+	record.SshfpFingerprintType = plan.SshfpFingerprintType.ValueString()
+	record.SshfpFingerprint = plan.SshfpFingerprint.ValueString()
+	record.TlsaCertificateUsage = plan.TlsaCertificateUsage.ValueString()
+	record.TlsaSelector = plan.TlsaSelector.ValueString()
+	record.TlsaMatchingType = plan.TlsaMatchingType.ValueString()
+	record.TlsaCertificateAssociationData = plan.TlsaCertificateAssociationData.ValueString()
+	record.NaptrOrder = plan.NaptrOrder.ValueInt64()
+	record.NaptrPreference = plan.NaptrPreference.ValueInt64()
+	record.NaptrFlags = plan.NaptrFlags.ValueString()
+	record.NaptrServices = plan.NaptrServices.ValueString()
+	record.NaptrRegexp = plan.NaptrRegexp.ValueString()
+	record.NaptrReplacement = plan.NaptrReplacement.ValueString()
+	record.UriPriority = plan.UriPriority.ValueInt64()
+	record.UriWeight = plan.UriWeight.ValueInt64()
+	record.Uri = plan.Uri.ValueString()
 
 	err := r.client.CreateDnsZoneRecord(record, ctx)
 	if err != nil {
@@ -152,6 +225,8 @@ func (r *dnsZoneRecordResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	plan.ID = types.StringValue(zoneRecordID(plan))
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -176,6 +251,8 @@ func (r *dnsZoneRecordResource) UpdateZoneRecord(ctx context.Context, state dnsZ
 	record.UpdateSvcbHints = plan.UpdateSvcbHints.ValueBool()
 	record.NameServer = state.NameServer.ValueString()
 	record.Cname = plan.Cname.ValueString()
+	record.Aname = state.Aname.ValueString()
+	record.Dname = plan.Dname.ValueString()
 	record.PtrName = state.PtrName.ValueString()
 	record.Exchange = state.Exchange.ValueString()
 	record.Preference = plan.Preference.ValueInt64()
@@ -193,6 +270,74 @@ func (r *dnsZoneRecordResource) UpdateZoneRecord(ctx context.Context, state dnsZ
 	record.AppName = plan.AppName.ValueString()
 	record.ClassPath = plan.ClassPath.ValueString()
 	record.RecordData = plan.RecordData.ValueString()
+	record.Priority = state.Priority.ValueInt64()
+	record.Weight = state.Weight.ValueInt64()
+	record.Port = state.Port.ValueInt64()
+	record.Target = state.Target.ValueString()
+
+	// The remaining record data is always sent in full with the new values, as zero is a valid priority, weight, port
+	// and flags value and cannot mean unchanged
+	record.NewPriority = plan.Priority.ValueInt64()
+	record.NewWeight = plan.Weight.ValueInt64()
+	record.NewPort = plan.Port.ValueInt64()
+	record.NewTarget = plan.Target.ValueString()
+
+	record.Flags = state.Flags.ValueInt64()
+	record.Tag = state.Tag.ValueString()
+	record.Value = state.Value.ValueString()
+	record.NewFlags = plan.Flags.ValueInt64()
+	record.NewTag = plan.Tag.ValueString()
+	record.NewValue = plan.Value.ValueString()
+
+	record.SvcPriority = state.SvcPriority.ValueInt64()
+	record.SvcTargetName = state.SvcTargetName.ValueString()
+	record.SvcParams = convertMapValueToStringMap(state.SvcParams)
+	record.NewSvcPriority = plan.SvcPriority.ValueInt64()
+	record.NewSvcTargetName = plan.SvcTargetName.ValueString()
+	record.NewSvcParams = convertMapValueToStringMap(plan.SvcParams)
+
+	record.KeyTag = state.KeyTag.ValueInt64()
+	record.Algorithm = state.Algorithm.ValueString()
+	record.DigestType = state.DigestType.ValueString()
+	record.Digest = state.Digest.ValueString()
+	record.SshfpAlgorithm = state.SshfpAlgorithm.ValueString()
+	record.SshfpFingerprintType = state.SshfpFingerprintType.ValueString()
+	record.SshfpFingerprint = state.SshfpFingerprint.ValueString()
+	record.TlsaCertificateUsage = state.TlsaCertificateUsage.ValueString()
+	record.TlsaSelector = state.TlsaSelector.ValueString()
+	record.TlsaMatchingType = state.TlsaMatchingType.ValueString()
+	record.TlsaCertificateAssociationData = state.TlsaCertificateAssociationData.ValueString()
+	record.NewKeyTag = plan.KeyTag.ValueInt64()
+	record.NewAlgorithm = plan.Algorithm.ValueString()
+	record.NewDigestType = plan.DigestType.ValueString()
+	record.NewDigest = plan.Digest.ValueString()
+	record.NewSshfpAlgorithm = plan.SshfpAlgorithm.ValueString()
+	record.NewSshfpFingerprintType = plan.SshfpFingerprintType.ValueString()
+	record.NewSshfpFingerprint = plan.SshfpFingerprint.ValueString()
+	record.NewTlsaCertificateUsage = plan.TlsaCertificateUsage.ValueString()
+	record.NewTlsaSelector = plan.TlsaSelector.ValueString()
+	record.NewTlsaMatchingType = plan.TlsaMatchingType.ValueString()
+	record.NewTlsaCertificateAssociationData = plan.TlsaCertificateAssociationData.ValueString()
+
+	record.NaptrOrder = state.NaptrOrder.ValueInt64()
+	record.NaptrPreference = state.NaptrPreference.ValueInt64()
+	record.NaptrFlags = state.NaptrFlags.ValueString()
+	record.NaptrServices = state.NaptrServices.ValueString()
+	record.NaptrRegexp = state.NaptrRegexp.ValueString()
+	record.NaptrReplacement = state.NaptrReplacement.ValueString()
+	record.NewNaptrOrder = plan.NaptrOrder.ValueInt64()
+	record.NewNaptrPreference = plan.NaptrPreference.ValueInt64()
+	record.NewNaptrFlags = plan.NaptrFlags.ValueString()
+	record.NewNaptrServices = plan.NaptrServices.ValueString()
+	record.NewNaptrRegexp = plan.NaptrRegexp.ValueString()
+	record.NewNaptrReplacement = plan.NaptrReplacement.ValueString()
+
+	record.UriPriority = state.UriPriority.ValueInt64()
+	record.UriWeight = state.UriWeight.ValueInt64()
+	record.Uri = state.Uri.ValueString()
+	record.NewUriPriority = plan.UriPriority.ValueInt64()
+	record.NewUriWeight = plan.UriWeight.ValueInt64()
+	record.NewUri = plan.Uri.ValueString()
 
 	if state.Domain.ValueString() != plan.Domain.ValueString() {
 		record.NewDomain = plan.Domain.ValueString()
@@ -210,6 +355,10 @@ func (r *dnsZoneRecordResource) UpdateZoneRecord(ctx context.Context, state dnsZ
 		record.NewPtrName = plan.PtrName.ValueString()
 	}
 
+	if state.Aname.ValueString() != plan.Aname.ValueString() {
+		record.NewAname = plan.Aname.ValueString()
+	}
+
 	if state.Exchange.ValueString() != plan.Exchange.ValueString() {
 		record.NewExchange = plan.Exchange.ValueString()
 	}
@@ -248,14 +397,30 @@ func (r *dnsZoneRecordResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	// Check if the record exists
-	record, err := r.client.GetDnsZoneRecord(state.Domain.ValueString(), state.Type.ValueString(), ctx)
+	records, err := r.client.GetDnsZoneRecordSet(state.Domain.ValueString(), state.Type.ValueString(), ctx)
 	if err != nil {
 		tflog.Info(ctx, "Removing record "+state.Domain.ValueString()+" from state due to error: "+err.Error())
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
+	// Only the record with the value of the state is refreshed, other records of the name and type are ignored and a
+	// changed value shows as a removed record. A name can only have a single CNAME or DNAME record, so it is refreshed
+	// even when its target was changed.
+	value := zoneRecordValue(state)
+	index := slices.IndexFunc(records, func(record technitium.DnsZoneRecord) bool {
+		return technitium.RecordValue(record) == value
+	})
+	if index < 0 && len(records) > 0 && (state.Type.ValueString() == "CNAME" || state.Type.ValueString() == "DNAME") {
+		index = 0
+	}
+	if index < 0 {
+		tflog.Info(ctx, "Removing record "+state.Domain.ValueString()+" from state as no "+state.Type.ValueString()+" record has value "+value)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	record := records[index]
+
 	state.Domain = types.StringValue(record.Name)
 	state.Type = types.StringValue(record.Type)
 	state.Disabled = types.BoolValue(record.Disabled)
@@ -263,10 +428,8 @@ func (r *dnsZoneRecordResource) Read(ctx context.Context, req resource.ReadReque
 	state.Comments = types.StringValue(record.Comments)
 	state.ExpiryTTL = types.Int64Value(record.ExpiryTTL)
 
-	setStringIfNotEmpty(&state.Cname, record.RecordData.Cname)
-	setStringIfNotEmpty(&state.IPAddress, record.RecordData.IpAddress)
-	setStringIfNotEmpty(&state.Forwarder, record.RecordData.Forwarder)
-	setStringIfNotEmpty(&state.NameServer, record.RecordData.NameServer)
+	refreshZoneRecordData(&state, record.RecordData)
+	state.ID = types.StringValue(zoneRecordID(state))
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -285,13 +448,8 @@ func (r *dnsZoneRecordResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	record := technitium.DnsZoneRecordCreate{}
-
-	record.Type = state.Type.ValueString()
-	record.Domain = state.Domain.ValueString()
-	record.Zone = state.Zone.ValueString()
-	record.IPAddress = state.IPAddress.ValueString()
-	record.PtrName = state.PtrName.ValueString()
+	// The record data selects the record to delete among the records of the name and type
+	record := technitium.NewDnsZoneRecordCreate(state.Zone.ValueString(), state.Domain.ValueString(), state.Type.ValueString(), 0, zoneRecordData(state))
 
 	err := r.client.DeleteDnsZoneRecord(record, ctx)
 
@@ -304,10 +462,435 @@ func (r *dnsZoneRecordResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 }
 
-func (r *dnsZoneRecordResource) ModifyPlan(_ context.Context, _ resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+func (r *dnsZoneRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	resp.RequiresReplace = path.Paths{
 		path.Root("domain"),
 		path.Root("zone"),
 		path.Root("type"),
 	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan dnsZoneRecordCreate
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The identifier follows the record value, which can be changed in place
+	id := types.StringUnknown()
+	if zoneRecordIdentityKnown(plan) {
+		id = types.StringValue(zoneRecordID(plan))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), id)...)
+
+	if plan.Type.ValueString() == "CNAME" {
+		checkCnameAtApex(ctx, r.client, plan.Zone, plan.Domain, &resp.Diagnostics)
+	}
+
+	if plan.Type.ValueString() != "APP" || plan.AppName.IsUnknown() || plan.ClassPath.IsUnknown() {
+		return
+	}
+
+	// The app is only checked when it changes, so that records of an app that was removed can still be destroyed
+	if !req.State.Raw.IsNull() {
+		var state dnsZoneRecordCreate
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.AppName.Equal(state.AppName) && plan.ClassPath.Equal(state.ClassPath) {
+			return
+		}
+	}
+
+	r.validateAppRecord(ctx, plan, resp)
+}
+
+// validateAppRecord checks that the app of an APP record is installed and that the class path is one of its APP record
+// handlers. The check is skipped with a warning when the apps cannot be listed.
+func (r *dnsZoneRecordResource) validateAppRecord(ctx context.Context, plan dnsZoneRecordCreate, resp *resource.ModifyPlanResponse) {
+
+	if r.client == nil {
+		return
+	}
+
+	apps, err := r.client.ListDnsApps(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could not check DNS app",
+			"The installed DNS apps could not be listed to check app "+plan.AppName.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	installed := make([]string, 0, len(apps))
+	for _, app := range apps {
+		installed = append(installed, app.Name)
+		if app.Name != plan.AppName.ValueString() {
+			continue
+		}
+
+		classPaths := app.AppRecordClassPaths()
+		if plan.ClassPath.IsNull() || !slices.Contains(classPaths, plan.ClassPath.ValueString()) {
+			resp.Diagnostics.AddAttributeError(path.Root("class_path"), "Invalid DNS app class path",
+				"`class_path` must be one of the APP record handlers of app "+app.Name+": ["+strings.Join(classPaths, ", ")+"], got `"+plan.ClassPath.ValueString()+"`.")
+		}
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(path.Root("app_name"), "DNS app not installed",
+		"App `"+plan.AppName.ValueString()+"` is not installed on the server. Installed apps: ["+strings.Join(installed, ", ")+"].")
+}
+
+// ImportState imports an existing record using an identifier of the form `zone/name/type/value`, the value being the
+// record data in its zone file form. The value can be omitted for types with a single record, such as CNAME or APP.
+func (r *dnsZoneRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	// The value can contain slashes, for example in the URI of an URI record
+	parts := strings.SplitN(req.ID, "/", 4)
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected an import ID of the form `zone/name/type/value`, got `"+req.ID+"`.",
+		)
+		return
+	}
+
+	zone, domain, recordType := parts[0], parts[1], strings.ToUpper(parts[2])
+
+	value := ""
+	if len(parts) == 4 && parts[3] != "" {
+		var err error
+		value, err = technitium.NormalizeRecordValue(recordType, parts[3])
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid import ID",
+				"Could not parse the record value of `"+req.ID+"`: "+err.Error(),
+			)
+			return
+		}
+	} else {
+		// Without a value the record is only identified when the name has a single record of the type
+		records, err := r.client.GetDnsZoneRecordSet(domain, recordType, ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importing zone record",
+				"Could not read zone records "+req.ID+": "+err.Error(),
+			)
+			return
+		}
+		if len(records) > 1 {
+			resp.Diagnostics.AddError(
+				"Ambiguous import ID",
+				"Domain "+domain+" has "+strconv.Itoa(len(records))+" "+recordType+" records, use an import ID of the form `zone/name/type/value` to select one.",
+			)
+			return
+		}
+	}
+
+	record, err := r.client.GetDnsZoneRecordByValue(domain, recordType, value, ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing zone record",
+			"Could not find zone record "+req.ID+": "+err.Error(),
+		)
+		return
+	}
+
+	state := dnsZoneRecordCreate{
+		Zone:      types.StringValue(zone),
+		Domain:    types.StringValue(record.Name),
+		Type:      types.StringValue(record.Type),
+		Disabled:  types.BoolValue(record.Disabled),
+		TTL:       types.Int64Value(record.TTL),
+		Comments:  types.StringValue(record.Comments),
+		ExpiryTTL: types.Int64Value(record.ExpiryTTL),
+		SvcParams: types.MapNull(types.StringType),
+	}
+	refreshZoneRecordData(&state, record.RecordData)
+	state.ID = types.StringValue(zoneRecordID(state))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// zoneRecordData returns the record data of the attributes that identify the record.
+func zoneRecordData(m dnsZoneRecordCreate) technitium.DnsZoneRecordData {
+	data := technitium.DnsZoneRecordData{
+		IpAddress:  m.IPAddress.ValueString(),
+		NameServer: m.NameServer.ValueString(),
+		Cname:      m.Cname.ValueString(),
+		Aname:      m.Aname.ValueString(),
+		Dname:      m.Dname.ValueString(),
+		PtrName:    m.PtrName.ValueString(),
+		Exchange:   m.Exchange.ValueString(),
+		Preference: m.Preference.ValueInt64(),
+		Text:       m.Text.ValueString(),
+		Protocol:   m.Protocol.ValueString(),
+		Forwarder:  m.Forwarder.ValueString(),
+		Priority:   m.Priority.ValueInt64(),
+		Weight:     m.Weight.ValueInt64(),
+		Port:       m.Port.ValueInt64(),
+		Target:     m.Target.ValueString(),
+		Flags:      m.Flags.ValueInt64(),
+		Tag:        m.Tag.ValueString(),
+		Value:      m.Value.ValueString(),
+
+		SvcPriority: m.SvcPriority.ValueInt64(),
+		TargetName:  m.SvcTargetName.ValueString(),
+		SvcParams:   convertMapValueToStringMap(m.SvcParams),
+
+		KeyTag:                     m.KeyTag.ValueInt64(),
+		Algorithm:                  m.Algorithm.ValueString(),
+		DigestType:                 m.DigestType.ValueString(),
+		Digest:                     m.Digest.ValueString(),
+		FingerprintType:            m.SshfpFingerprintType.ValueString(),
+		Fingerprint:                m.SshfpFingerprint.ValueString(),
+		CertificateUsage:           m.TlsaCertificateUsage.ValueString(),
+		Selector:                   m.TlsaSelector.ValueString(),
+		MatchingType:               m.TlsaMatchingType.ValueString(),
+		CertificateAssociationData: m.TlsaCertificateAssociationData.ValueString(),
+	}
+
+	// Some record types return their data in the same properties as other types
+	switch m.Type.ValueString() {
+	case "SSHFP":
+		data.Algorithm = m.SshfpAlgorithm.ValueString()
+	case "NAPTR":
+		data.Order = m.NaptrOrder.ValueInt64()
+		data.Preference = m.NaptrPreference.ValueInt64()
+		data.NaptrFlags = m.NaptrFlags.ValueString()
+		data.Services = m.NaptrServices.ValueString()
+		data.Regexp = m.NaptrRegexp.ValueString()
+		data.Replacement = m.NaptrReplacement.ValueString()
+	case "URI":
+		data.Priority = m.UriPriority.ValueInt64()
+		data.Weight = m.UriWeight.ValueInt64()
+		data.Uri = m.Uri.ValueString()
+	}
+
+	return data
+}
+
+// refreshZoneRecordData refreshes the record data attributes of the record type. Values equivalent to the attributes are
+// kept in their configured form and optional attributes that are not set stay null while the record has their default.
+func refreshZoneRecordData(m *dnsZoneRecordCreate, d technitium.DnsZoneRecordData) {
+	switch m.Type.ValueString() {
+	case "A", "AAAA":
+		setIPAddress(&m.IPAddress, d.IpAddress)
+	case "NS":
+		setDomainName(&m.NameServer, d.NameServer)
+	case "CNAME":
+		setDomainName(&m.Cname, d.Cname)
+	case "ANAME":
+		setDomainName(&m.Aname, d.Aname)
+	case "DNAME":
+		setDomainName(&m.Dname, d.Dname)
+	case "PTR":
+		setDomainName(&m.PtrName, d.PtrName)
+	case "MX":
+		m.Preference = types.Int64Value(d.Preference)
+		setDomainName(&m.Exchange, d.Exchange)
+	case "TXT":
+		m.Text = types.StringValue(d.Text)
+		// The split text option is a string attribute holding a boolean
+		if split, err := strconv.ParseBool(m.SplitText.ValueString()); (err != nil || split != d.SplitText) && (d.SplitText || !m.SplitText.IsNull()) {
+			m.SplitText = types.StringValue(strconv.FormatBool(d.SplitText))
+		}
+	case "FWD":
+		setStringIgnoringCase(&m.Protocol, d.Protocol)
+		setStringIgnoringCase(&m.Forwarder, d.Forwarder)
+		setOptionalInt64(&m.ForwarderPriority, d.Priority)
+		setOptionalBool(&m.DnssecValidation, d.DnssecValidation)
+		// The server reports a proxy type for every forwarder, without a proxy it is one of the defaults
+		if !m.ProxyType.IsNull() || (d.ProxyType != "NoProxy" && d.ProxyType != "DefaultProxy") {
+			setOptionalString(&m.ProxyType, d.ProxyType, strings.EqualFold)
+		}
+		setOptionalString(&m.ProxyAddress, d.ProxyAddress, strings.EqualFold)
+		setOptionalInt64(&m.ProxyPort, d.ProxyPort)
+		setOptionalString(&m.ProxyUsername, d.ProxyUsername, stringsEqual)
+		setOptionalString(&m.ProxyPassword, d.ProxyPassword, stringsEqual)
+	case "SRV":
+		m.Priority = types.Int64Value(d.Priority)
+		m.Weight = types.Int64Value(d.Weight)
+		m.Port = types.Int64Value(d.Port)
+		setDomainName(&m.Target, d.Target)
+	case "CAA":
+		m.Flags = types.Int64Value(d.Flags)
+		m.Tag = types.StringValue(d.Tag)
+		m.Value = types.StringValue(d.Value)
+	case "SVCB", "HTTPS":
+		m.SvcPriority = types.Int64Value(d.SvcPriority)
+		setDomainName(&m.SvcTargetName, d.TargetName)
+		if len(d.SvcParams) > 0 || !m.SvcParams.IsNull() {
+			m.SvcParams = convertStringMapToMapValue(d.SvcParams)
+		}
+	case "DS":
+		m.KeyTag = types.Int64Value(d.KeyTag)
+		setCanonicalString(&m.Algorithm, d.Algorithm, technitium.CanonicalDnssecAlgorithm)
+		setCanonicalString(&m.DigestType, d.DigestType, technitium.CanonicalDsDigestType)
+		setHexString(&m.Digest, d.Digest)
+	case "SSHFP":
+		setStringIgnoringCase(&m.SshfpAlgorithm, d.Algorithm)
+		setStringIgnoringCase(&m.SshfpFingerprintType, d.FingerprintType)
+		setHexString(&m.SshfpFingerprint, d.Fingerprint)
+	case "TLSA":
+		setStringIgnoringCase(&m.TlsaCertificateUsage, d.CertificateUsage)
+		setStringIgnoringCase(&m.TlsaSelector, d.Selector)
+		setStringIgnoringCase(&m.TlsaMatchingType, d.MatchingType)
+		setHexString(&m.TlsaCertificateAssociationData, d.CertificateAssociationData)
+	case "NAPTR":
+		m.NaptrOrder = types.Int64Value(d.Order)
+		m.NaptrPreference = types.Int64Value(d.Preference)
+		setOptionalString(&m.NaptrFlags, d.NaptrFlags, strings.EqualFold)
+		setOptionalString(&m.NaptrServices, d.Services, strings.EqualFold)
+		setOptionalString(&m.NaptrRegexp, d.Regexp, stringsEqual)
+		if d.Replacement != "" || !m.NaptrReplacement.IsNull() {
+			setDomainName(&m.NaptrReplacement, d.Replacement)
+		}
+	case "URI":
+		m.UriPriority = types.Int64Value(d.Priority)
+		m.UriWeight = types.Int64Value(d.Weight)
+		m.Uri = types.StringValue(d.Uri)
+	case "APP":
+		setOptionalString(&m.AppName, d.AppName, stringsEqual)
+		setOptionalString(&m.ClassPath, d.ClassPath, stringsEqual)
+		// The document is compared semantically by its type, so it is kept as returned by the server
+		if d.Data != "" || !m.RecordData.IsNull() {
+			m.RecordData = newJsonStringValue(d.Data)
+		}
+	}
+}
+
+// checkCnameAtApex adds an error when a CNAME record of the domain would be added at the apex of its zone. Without a
+// zone, the record goes to the closest zone of the domain, which is looked up on the server.
+func checkCnameAtApex(ctx context.Context, client *technitium.Client, zone types.String, domain types.String, diags *diag.Diagnostics) {
+
+	if zone.IsUnknown() || domain.IsUnknown() {
+		return
+	}
+
+	zoneName := zone.ValueString()
+	if zone.IsNull() {
+		if client == nil {
+			return
+		}
+		closest, err := client.GetClosestDnsZone(domain.ValueString(), ctx)
+		if err != nil {
+			// A missing zone is reported by the server when the record is added
+			tflog.Debug(ctx, "Could not find the zone of domain "+domain.ValueString()+": "+err.Error())
+			return
+		}
+		zoneName = closest
+	}
+
+	if isZoneApex(domain.ValueString(), zoneName) {
+		diags.AddAttributeError(path.Root("type"), "CNAME at zone apex",
+			"A CNAME record cannot be added at the apex of zone "+zoneName+" as it would conflict with the SOA and NS records, use an ANAME record instead.")
+	}
+}
+
+// isZoneApex reports whether the domain name is the name of the zone.
+func isZoneApex(domain string, zone string) bool {
+	return strings.EqualFold(strings.TrimSuffix(domain, "."), strings.TrimSuffix(zone, "."))
+}
+
+// setDomainName refreshes a domain name attribute unless it only differs in case or the trailing dot.
+func setDomainName(target *types.String, value string) {
+	if strings.EqualFold(strings.TrimSuffix(target.ValueString(), "."), strings.TrimSuffix(value, ".")) {
+		return
+	}
+	*target = types.StringValue(value)
+}
+
+// setOptionalString refreshes an optional attribute unless the value is equal or the attribute is unset and the value empty.
+func setOptionalString(target *types.String, value string, equal func(string, string) bool) {
+	if target.IsNull() && value == "" {
+		return
+	}
+	if !equal(target.ValueString(), value) {
+		*target = types.StringValue(value)
+	}
+}
+
+// setOptionalInt64 refreshes an optional attribute unless it is unset and the value is zero.
+func setOptionalInt64(target *types.Int64, value int64) {
+	if target.IsNull() && value == 0 {
+		return
+	}
+	*target = types.Int64Value(value)
+}
+
+// setOptionalBool refreshes an optional attribute unless it is unset and the value is false.
+func setOptionalBool(target *types.Bool, value bool) {
+	if target.IsNull() && !value {
+		return
+	}
+	*target = types.BoolValue(value)
+}
+
+// setIPAddress refreshes an IP address attribute unless it is the same address in another notation.
+func setIPAddress(target *types.String, value string) {
+	if current := net.ParseIP(target.ValueString()); current != nil && current.Equal(net.ParseIP(value)) {
+		return
+	}
+	*target = types.StringValue(value)
+}
+
+func stringsEqual(a, b string) bool {
+	return a == b
+}
+
+// setStringIgnoringCase refreshes an attribute holding a name, such as an algorithm, unless it only differs in case.
+func setStringIgnoringCase(target *types.String, value string) {
+	if !strings.EqualFold(target.ValueString(), value) {
+		*target = types.StringValue(value)
+	}
+}
+
+// setCanonicalString refreshes an attribute holding a name that has several spellings, such as a DS algorithm that can
+// be given by its number, unless both have the same canonical form.
+func setCanonicalString(target *types.String, value string, canonical func(string) string) {
+	if canonical(target.ValueString()) != canonical(value) {
+		*target = types.StringValue(value)
+	}
+}
+
+// setHexString refreshes an attribute holding hexadecimal data unless it only differs in case or spacing.
+func setHexString(target *types.String, value string) {
+	if technitium.NormalizeHex(target.ValueString()) != technitium.NormalizeHex(value) {
+		*target = types.StringValue(value)
+	}
+}
+
+func zoneRecordValue(m dnsZoneRecordCreate) string {
+	return technitium.FormatRecordValue(m.Type.ValueString(), zoneRecordData(m))
+}
+
+func zoneRecordID(m dnsZoneRecordCreate) string {
+	return strings.Join([]string{
+		m.Zone.ValueString(),
+		strings.TrimSuffix(strings.ToLower(m.Domain.ValueString()), "."),
+		m.Type.ValueString(),
+		zoneRecordValue(m),
+	}, "/")
+}
+
+func zoneRecordIdentityKnown(m dnsZoneRecordCreate) bool {
+	for _, value := range []attr.Value{
+		m.Zone, m.Domain, m.Type, m.IPAddress, m.NameServer, m.Cname, m.Aname, m.Dname, m.PtrName,
+		m.Exchange, m.Preference, m.Text, m.Protocol, m.Forwarder,
+		m.Priority, m.Weight, m.Port, m.Target, m.Flags, m.Tag, m.Value,
+		m.SvcPriority, m.SvcTargetName, m.SvcParams, m.KeyTag, m.Algorithm, m.DigestType, m.Digest,
+		m.SshfpAlgorithm, m.SshfpFingerprintType, m.SshfpFingerprint, m.TlsaCertificateUsage, m.TlsaSelector,
+		m.TlsaMatchingType, m.TlsaCertificateAssociationData, m.NaptrOrder, m.NaptrPreference, m.NaptrFlags,
+		m.NaptrServices, m.NaptrRegexp, m.NaptrReplacement, m.UriPriority, m.UriWeight, m.Uri,
+	} {
+		if value.IsUnknown() {
+			return false
+		}
+	}
+	return true
 }
@@ -2,6 +2,11 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"terraform-provider-technitium/internal/technitium"
+	"terraform-provider-technitium/internal/test"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -70,6 +75,61 @@ func TestAccDnsZoneRecord_App(t *testing.T) {
 					resource.TestCheckResourceAttr("technitium_dns_zone_record.app", "ttl", "0"),
 				),
 			},
+			{
+				// The same record data in another format is not a change
+				Config: GetFileConfig(t, "dns_zone_record_app_reformatted.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestDnsZoneRecord_appValidation(t *testing.T) {
+
+	server := test.NewTestServer(test.GetMockScenarioFromFile(t, "../test/mocks/dns_apps_response.json", http.StatusOK))
+	defer server.Close()
+
+	config := func(appName string, classPath string, recordData string) string {
+		return fmt.Sprintf(`
+provider "technitium" {
+  host = "%s"
+  token = "test"
+}
+
+resource "technitium_dns_zone_record" "test" {
+  zone        = "example.com"
+  domain      = "geo.example.com"
+  type        = "APP"
+  app_name    = %q
+  class_path  = %q
+  record_data = %q
+}
+`, server.URL, appName, classPath, recordData)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("Geo Contry", "GeoCountry.Address", `{"default": ["192.0.2.1"]}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("App `Geo Contry` is not installed"),
+			},
+			{
+				Config:      config("Geo Country", "GeoCountry.Adress", `{"default": ["192.0.2.1"]}`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`class_path` must be one of the APP record handlers"),
+			},
+			{
+				Config:      config("Geo Country", "GeoCountry.Address", `{"default": ["192.0.2.1"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid JSON value"),
+			},
 		},
 	})
 }
@@ -113,6 +173,84 @@ func TestAccDnsZoneRecord_drift(t *testing.T) {
 	})
 }
 
+func TestAccDnsZoneRecord_recordDataDrift(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone_record_data_drift.tf"),
+			},
+			{
+				// Simulate drift by changing the record data outside Terraform
+				PreConfig: func() {
+					ctx := context.Background()
+					err := changeZoneRecord(ctx, technitium.DnsZoneRecordUpdate{
+						DnsZoneRecordCreate: technitium.DnsZoneRecordCreate{
+							Domain: "alias.example.com",
+							Type:   "CNAME",
+							Zone:   "example.com",
+							TTL:    3600,
+							Cname:  "other.example.com",
+						},
+					})
+					if err != nil {
+						t.Fatalf("Error updating DNS zone record: %v", err)
+					}
+					err = changeZoneRecord(ctx, technitium.DnsZoneRecordUpdate{
+						DnsZoneRecordCreate: technitium.DnsZoneRecordCreate{
+							Domain:    "example.com",
+							Type:      "TXT",
+							Zone:      "example.com",
+							TTL:       3600,
+							Text:      "v=spf1 -all",
+							SplitText: "true",
+						},
+						NewText:      "v=spf1 -all",
+						NewSplitText: "true",
+					})
+					if err != nil {
+						t.Fatalf("Error updating DNS zone record: %v", err)
+					}
+					// A record with a changed value is a different record
+					err = changeZoneRecord(ctx, technitium.DnsZoneRecordUpdate{
+						DnsZoneRecordCreate: technitium.DnsZoneRecordCreate{
+							Domain:     "example.com",
+							Type:       "MX",
+							Zone:       "example.com",
+							TTL:        3600,
+							Exchange:   "mail.example.com",
+							Preference: 10,
+						},
+						NewExchange:   "mail.example.com",
+						NewPreference: 20,
+					})
+					if err != nil {
+						t.Fatalf("Error updating DNS zone record: %v", err)
+					}
+				},
+				Config: GetFileConfig(t, "dns_zone_record_data_drift.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone_record.cname", plancheck.ResourceActionUpdate),
+						ExpectDrift("technitium_dns_zone_record.cname", "cname", "other.example.com"),
+						plancheck.ExpectResourceAction("technitium_dns_zone_record.txt", plancheck.ResourceActionUpdate),
+						ExpectDrift("technitium_dns_zone_record.txt", "split_text", "true"),
+						plancheck.ExpectResourceAction("technitium_dns_zone_record.mx", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_record_data_drift.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func TestAccDnsZoneRecord_ptrDelete(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -143,3 +281,408 @@ func TestAccDnsZoneRecord_ptrDelete(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDnsZoneRecord_sameNameAndType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone_record_round_robin.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.first", "id", "example.com/www.example.com/A/192.0.2.1"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.first", "ttl", "300"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.second", "id", "example.com/www.example.com/A/192.0.2.2"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.second", "ttl", "600"),
+				),
+			},
+			{
+				// Each resource reads back its own record
+				Config: GetFileConfig(t, "dns_zone_record_round_robin.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// The identifier selects the record among the records of the name and type
+				ResourceName:      "technitium_dns_zone_record.second",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Only the record that was deleted outside Terraform is created again
+				PreConfig: func() {
+					err := deleteZoneRecord(context.Background(), technitium.DnsZoneRecordCreate{
+						Domain:    "www.example.com",
+						Type:      "A",
+						Zone:      "example.com",
+						IPAddress: "192.0.2.2",
+					})
+					if err != nil {
+						t.Fatalf("Error deleting DNS zone record: %v", err)
+					}
+				},
+				Config: GetFileConfig(t, "dns_zone_record_round_robin.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone_record.first", plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction("technitium_dns_zone_record.second", plancheck.ResourceActionCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDnsZoneRecord_srv(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone_record_srv.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.srv", "id", "example.com/_sip._tcp.example.com/SRV/10 60 5060 sip.example.com"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.srv", "port", "5060"),
+				),
+			},
+			{
+				ResourceName:      "technitium_dns_zone_record.srv",
+				ImportState:       true,
+				ImportStateId:     "example.com/_sip._tcp.example.com/SRV/10 60 5060 SIP.example.com.",
+				ImportStateVerify: true,
+				// The target is read back without the trailing dot of the configuration
+				ImportStateVerifyIgnore: []string{"target"},
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_record_srv.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_record_srv_updated.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone_record.srv", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.srv", "priority", "0"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.srv", "target", "sip2.example.com"),
+				),
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_record_srv_updated.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDnsZoneRecord_caa(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone_record_caa.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.issue", "id", `example.com/example.com/CAA/0 issue "letsencrypt.org"`),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.iodef", "value", "mailto:security@example.com"),
+				),
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_record_caa.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestDnsZoneRecord_caaValidation(t *testing.T) {
+
+	server := test.NewTestServer(test.Scenario{ExpectedStatus: http.StatusOK, ExpectedBody: `{"status":"ok"}`})
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "technitium" {
+  host = "%s"
+  token = "test"
+}
+
+resource "technitium_dns_zone_record" "test" {
+  zone   = "example.com"
+  domain = "example.com"
+  type   = "CAA"
+  flags  = 0
+  tag    = "issuer"
+  value  = "letsencrypt.org"
+}
+`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Valid values are `issue`, `issuewild` and `iodef`"),
+			},
+		},
+	})
+}
+
+func TestAccDnsZoneRecord_https(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone_record_https.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.https", "id", "example.com/example.com/HTTPS/1 . alpn=h2,h3 port=443"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.https", "svc_params.alpn", "h2,h3"),
+				),
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_record_https.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_record_https_updated.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone_record.https", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.https", "svc_params.%", "2"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.https", "svc_params.ipv4hint", "192.0.2.1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDnsZoneRecord_securityTypes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone_record_security.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.tlsa", "tlsa_matching_type", "SHA2-256"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.sshfp", "sshfp_fingerprint_type", "SHA256"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.ds", "key_tag", "12345"),
+				),
+			},
+			{
+				// Hex data returned in a different case must not show a diff
+				Config: GetFileConfig(t, "dns_zone_record_security.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDnsZoneRecord_naptrAndUri(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone_record_naptr_uri.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.naptr", "naptr_services", "E2U+sip"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.naptr", "id", `example.com/voip.example.com/NAPTR/100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" .`),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.uri", "uri", "https://www.example.com/"),
+				),
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_record_naptr_uri.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_record_naptr_uri_updated.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone_record.naptr", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("technitium_dns_zone_record.uri", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.naptr", "naptr_preference", "20"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.uri", "uri_weight", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDnsZoneRecord_anameAndDname(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone_record_aname_dname.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.aname", "id", "example.com/example.com/ANAME/lb.example.net"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.dname", "dname", "example.net"),
+				),
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_record_aname_dname.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_record_aname_dname_updated.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone_record.aname", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("technitium_dns_zone_record.dname", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.aname", "aname", "lb2.example.net"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_record.dname", "dname", "example.org"),
+				),
+			},
+		},
+	})
+}
+
+func TestDnsZoneRecord_cnameAtApexValidation(t *testing.T) {
+
+	server := test.NewTestServer(test.Scenario{ExpectedStatus: http.StatusOK, ExpectedBody: `{"status":"ok"}`})
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "technitium" {
+  host = "%s"
+  token = "test"
+}
+
+resource "technitium_dns_zone_record" "test" {
+  zone   = "example.com"
+  domain = "Example.com."
+  type   = "CNAME"
+  cname  = "lb.example.net"
+}
+`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("use an ANAME record instead"),
+			},
+		},
+	})
+}
+
+func TestDnsZoneRecord_cnameAtApexWithoutZone(t *testing.T) {
+
+	server := test.NewTestServer(test.GetMockScenarioFromFile(t, "../test/mocks/dns_zones_response.json", http.StatusOK))
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "technitium" {
+  host = "%s"
+  token = "test"
+}
+
+resource "technitium_dns_zone_record" "test" {
+  domain = "example3.com"
+  type   = "CNAME"
+  cname  = "lb.example.net"
+}
+
+resource "technitium_dns_record_set" "test" {
+  domain = "Example3.com."
+  type   = "CNAME"
+  values = ["lb.example.net"]
+}
+`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The zone of the record is the closest zone of the domain
+				Config:      config,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("cannot be added at the apex of zone example3.com"),
+			},
+		},
+	})
+}
+
+func TestDnsZoneRecord_importWithoutValue(t *testing.T) {
+
+	server := test.NewTestServer(test.Scenario{
+		ExpectedStatus: http.StatusOK,
+		ExpectedBody: `{"status":"ok","response":{"records":[` +
+			`{"name":"example.com","type":"MX","ttl":3600,"rData":{"preference":10,"exchange":"mail1.example.com"}},` +
+			`{"name":"example.com","type":"MX","ttl":3600,"rData":{"preference":20,"exchange":"mail2.example.com"}}]}}`,
+	})
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "technitium" {
+  host = "%s"
+  token = "test"
+}
+
+resource "technitium_dns_zone_record" "test" {
+  zone       = "example.com"
+  domain     = "example.com"
+  type       = "MX"
+  exchange   = "mail1.example.com"
+  preference = 10
+}
+`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// A record set with several records cannot be imported without the value
+				Config:        config,
+				ResourceName:  "technitium_dns_zone_record.test",
+				ImportState:   true,
+				ImportStateId: "example.com/example.com/MX",
+				ExpectError:   regexp.MustCompile("Domain example.com has 2 MX records"),
+			},
+		},
+	})
+}
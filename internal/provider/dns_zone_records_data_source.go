@@ -55,7 +55,7 @@ func (d *dnsZoneRecordsDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	data := dnsZoneRecords{}
+	data := dnsZoneRecords{Domain: state.Domain}
 
 	// Map response body to model
 	for _, record := range records {
@@ -81,53 +81,114 @@ func parseRecord(record technitium.DnsZoneRecord) *dnsZoneRecord {
 		LastUsedOn:   types.StringValue(record.LastUsedOn),
 		LastModified: types.StringValue(record.LastModified),
 		ExpiryTTL:    types.Int64Value(record.ExpiryTTL),
+		Comments:     types.StringValue(record.Comments),
 		RecordData:   getRecordData(record),
 	}
 }
 
+// getRecordData maps the rData properties that are set, the other attributes are null. Boolean properties
+// are only mapped for the record types that have them.
 func getRecordData(record technitium.DnsZoneRecord) *dnsZoneRecordData {
 
 	rd := record.RecordData
-	data := dnsZoneRecordData{}
-
-	if rd.PrimaryNameServer != "" {
-		data.PrimaryNameServer = types.StringValue(rd.PrimaryNameServer)
-	}
-	if rd.ResponsiblePerson != "" {
-		data.ResponsiblePerson = types.StringValue(rd.ResponsiblePerson)
-	}
-	if rd.Serial != 0 {
-		data.Serial = types.Int64Value(rd.Serial)
-	}
-	if rd.Refresh != 0 {
-		data.Refresh = types.Int64Value(rd.Refresh)
-	}
-	if rd.Retry != 0 {
-		data.Retry = types.Int64Value(rd.Retry)
-	}
-	if rd.Expire != 0 {
-		data.Expire = types.Int64Value(rd.Expire)
-	}
-	if rd.Minimum != 0 {
-		data.Minimum = types.Int64Value(rd.Minimum)
-	}
-	if rd.Protocol != "" {
-		data.Protocol = types.StringValue(rd.Protocol)
-	}
-	if rd.Forwarder != "" {
-		data.Forwarder = types.StringValue(rd.Forwarder)
-	}
-	if rd.Priority != 0 {
+	data := dnsZoneRecordData{
+		SvcParams: types.MapNull(types.StringType),
+	}
+
+	stringValues := map[*types.String]string{
+		&data.PrimaryNameServer:          rd.PrimaryNameServer,
+		&data.ResponsiblePerson:          rd.ResponsiblePerson,
+		&data.Protocol:                   rd.Protocol,
+		&data.Forwarder:                  rd.Forwarder,
+		&data.ProxyType:                  rd.ProxyType,
+		&data.ProxyAddress:               rd.ProxyAddress,
+		&data.ProxyUsername:              rd.ProxyUsername,
+		&data.IpAddress:                  rd.IpAddress,
+		&data.Cname:                      rd.Cname,
+		&data.NameServer:                 rd.NameServer,
+		&data.PtrName:                    rd.PtrName,
+		&data.Exchange:                   rd.Exchange,
+		&data.Text:                       rd.Text,
+		&data.Target:                     rd.Target,
+		&data.NaptrFlags:                 rd.NaptrFlags,
+		&data.Services:                   rd.Services,
+		&data.Regexp:                     rd.Regexp,
+		&data.Replacement:                rd.Replacement,
+		&data.Dname:                      rd.Dname,
+		&data.Aname:                      rd.Aname,
+		&data.Algorithm:                  rd.Algorithm,
+		&data.DigestType:                 rd.DigestType,
+		&data.Digest:                     rd.Digest,
+		&data.FingerprintType:            rd.FingerprintType,
+		&data.Fingerprint:                rd.Fingerprint,
+		&data.CertificateUsage:           rd.CertificateUsage,
+		&data.Selector:                   rd.Selector,
+		&data.MatchingType:               rd.MatchingType,
+		&data.CertificateAssociationData: rd.CertificateAssociationData,
+		&data.SvcMode:                    rd.SvcMode,
+		&data.TargetName:                 rd.TargetName,
+		&data.Uri:                        rd.Uri,
+		&data.Tag:                        rd.Tag,
+		&data.Value:                      rd.Value,
+		&data.AppName:                    rd.AppName,
+		&data.ClassPath:                  rd.ClassPath,
+		&data.Data:                       rd.Data,
+	}
+	for target, value := range stringValues {
+		setStringIfNotEmpty(target, value)
+	}
+
+	intValues := map[*types.Int64]int64{
+		&data.Serial:      rd.Serial,
+		&data.Refresh:     rd.Refresh,
+		&data.Retry:       rd.Retry,
+		&data.Expire:      rd.Expire,
+		&data.Minimum:     rd.Minimum,
+		&data.ProxyPort:   rd.ProxyPort,
+		&data.Weight:      rd.Weight,
+		&data.Port:        rd.Port,
+		&data.Order:       rd.Order,
+		&data.KeyTag:      rd.KeyTag,
+		&data.SvcPriority: rd.SvcPriority,
+	}
+	for target, value := range intValues {
+		if value != 0 {
+			*target = types.Int64Value(value)
+		}
+	}
+
+	// Zero is a meaningful priority, preference or flags value of the types that have them
+	switch record.Type {
+	case "SOA":
+		data.UseSerialDateScheme = types.BoolValue(rd.UseSerialDateScheme)
+	case "MX":
+		data.Preference = types.Int64Value(rd.Preference)
+	case "TXT":
+		data.SplitText = types.BoolValue(rd.SplitText)
+	case "SRV":
 		data.Priority = types.Int64Value(rd.Priority)
-	}
-	if rd.ProxyType != "" {
-		data.ProxyType = types.StringValue(rd.ProxyType)
-	}
-	if rd.IpAddress != "" {
-		data.IpAddress = types.StringValue(rd.IpAddress)
-	}
-	if rd.Cname != "" {
-		data.Cname = types.StringValue(rd.Cname)
+		data.Weight = types.Int64Value(rd.Weight)
+		data.Port = types.Int64Value(rd.Port)
+	case "NAPTR":
+		data.Order = types.Int64Value(rd.Order)
+		data.Preference = types.Int64Value(rd.Preference)
+	case "URI":
+		data.Priority = types.Int64Value(rd.Priority)
+		data.Weight = types.Int64Value(rd.Weight)
+	case "CAA":
+		data.Flags = types.Int64Value(rd.Flags)
+	case "DS":
+		data.KeyTag = types.Int64Value(rd.KeyTag)
+	case "SVCB", "HTTPS":
+		data.SvcPriority = types.Int64Value(rd.SvcPriority)
+		data.AutoIpv4Hint = types.BoolValue(rd.AutoIpv4Hint)
+		data.AutoIpv6Hint = types.BoolValue(rd.AutoIpv6Hint)
+		if rd.SvcParams != nil {
+			data.SvcParams = convertStringMapToMapValue(rd.SvcParams)
+		}
+	case "FWD":
+		data.Priority = types.Int64Value(rd.Priority)
+		data.DnssecValidation = types.BoolValue(rd.DnssecValidation)
 	}
 
 	return &data
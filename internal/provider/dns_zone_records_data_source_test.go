@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"terraform-provider-technitium/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDnsZoneRecordsDataSource(t *testing.T) {
+
+	scenario := test.GetMockScenarioFromFile(t, "../test/mocks/dns_zone_records_typed_response.json", http.StatusOK)
+	server := test.NewTestServer(scenario)
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "technitium" {
+  host = "%s"
+  token = "test"
+}
+
+data "technitium_dns_zone_records" "test" {
+  domain = "example.com"
+}
+`, server.URL)
+
+	records := "data.technitium_dns_zone_records.test"
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(records, "domain", "example.com"),
+					resource.TestCheckResourceAttr(records, "records.#", "8"),
+					resource.TestCheckResourceAttr(records, "records.0.comments", "primary mail"),
+					resource.TestCheckResourceAttr(records, "records.0.record_data.preference", "10"),
+					resource.TestCheckResourceAttr(records, "records.0.record_data.exchange", "mail.example.com"),
+					resource.TestCheckResourceAttr(records, "records.1.record_data.text", "v=spf1 mx -all"),
+					resource.TestCheckResourceAttr(records, "records.2.record_data.flags", "0"),
+					resource.TestCheckResourceAttr(records, "records.2.record_data.tag", "issue"),
+					resource.TestCheckResourceAttr(records, "records.3.record_data.port", "5060"),
+					resource.TestCheckResourceAttr(records, "records.3.record_data.target", "sip.example.com"),
+					resource.TestCheckResourceAttr(records, "records.4.record_data.naptr_flags", "S"),
+					resource.TestCheckResourceAttr(records, "records.5.record_data.protocol", "Udp"),
+					resource.TestCheckResourceAttr(records, "records.6.record_data.svc_params.alpn", "h2,h3"),
+					resource.TestCheckNoResourceAttr(records, "records.7.record_data.protocol"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,487 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-technitium/internal/technitium"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsZoneRecordsResource{}
+	_ resource.ResourceWithConfigure      = &dnsZoneRecordsResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsZoneRecordsResource{}
+	_ resource.ResourceWithValidateConfig = &dnsZoneRecordsResource{}
+	_ resource.ResourceWithImportState    = &dnsZoneRecordsResource{}
+)
+
+func NewDnsZoneRecordsResource() resource.Resource {
+	return &dnsZoneRecordsResource{}
+}
+
+type dnsZoneRecordsResource struct {
+	client *technitium.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *dnsZoneRecordsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.client = ConfigureResourceClient(req, resp)
+}
+
+// Metadata returns the resource type name.
+func (r *dnsZoneRecordsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_records"
+}
+
+func (r *dnsZoneRecordsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: DnsZoneRecordsResourceSchema(),
+		Description: "Manages every record of a zone from one list. Records of the zone that are not declared are deleted, " +
+			"except the apex NS records when `keep_apex_ns` is set. Records without a zone file form, such as the SOA record, " +
+			"the records signed by DNSSEC and APP records, are never changed.",
+	}
+}
+
+// ValidateConfig checks that every record value can be parsed and is only declared once.
+func (r *dnsZoneRecordsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+
+	var config dnsManagedZoneRecords
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Records.IsUnknown() || config.Records.IsNull() {
+		return
+	}
+
+	var records []dnsManagedZoneRecord
+	resp.Diagnostics.Append(config.Records.ElementsAs(ctx, &records, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	seen := make(map[string]string)
+	for _, record := range records {
+		if record.Domain.IsUnknown() || record.Type.IsUnknown() || record.Value.IsUnknown() {
+			continue
+		}
+
+		recordType := strings.ToUpper(record.Type.ValueString())
+		description := record.Domain.ValueString() + " " + recordType + " " + record.Value.ValueString()
+
+		if recordType == "CNAME" && !config.Zone.IsUnknown() && isZoneApex(record.Domain.ValueString(), config.Zone.ValueString()) {
+			resp.Diagnostics.AddAttributeError(path.Root("records"), "CNAME at zone apex",
+				"A CNAME record cannot be added at the apex of zone "+config.Zone.ValueString()+" as it would conflict with the SOA and NS records, use an ANAME record instead.")
+			continue
+		}
+
+		normalized, err := technitium.NormalizeRecordValue(recordType, record.Value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("records"), "Invalid record value", err.Error())
+			continue
+		}
+
+		key := managedZoneRecordKey(record.Domain.ValueString(), recordType, normalized)
+		if other, ok := seen[key]; ok {
+			resp.Diagnostics.AddAttributeError(path.Root("records"), "Duplicate record",
+				fmt.Sprintf("The records %q and %q are the same record.", other, description))
+			continue
+		}
+		seen[key] = description
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsZoneRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+
+	var plan dnsManagedZoneRecords
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.ReconcileRecords(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating zone records",
+			"Could not reconcile the records of zone "+plan.Zone.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsZoneRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+
+	var state dnsManagedZoneRecords
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := r.client.GetDnsZoneRecords(state.Zone.ValueString(), ctx)
+	if err != nil {
+		tflog.Info(ctx, "Removing zone records "+state.Zone.ValueString()+" from state due to error: "+err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	declared, diags := managedZoneRecords(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	byKey := make(map[string]managedZoneRecord, len(declared))
+	for _, record := range declared {
+		byKey[record.Key] = record
+	}
+
+	// Imported resources have no declared records yet, every record of the zone is then taken as declared
+	imported := state.Records.IsNull()
+
+	current := make([]dnsManagedZoneRecord, 0, len(records))
+	unmanaged := make([]dnsManagedZoneRecord, 0)
+	for _, record := range records {
+		if !isManagedZoneRecord(record, state) {
+			continue
+		}
+
+		value := technitium.RecordValue(record)
+		item := dnsManagedZoneRecord{
+			Domain: types.StringValue(record.Name),
+			Type:   types.StringValue(record.Type),
+			TTL:    types.Int64Value(record.TTL),
+			Value:  types.StringValue(value),
+		}
+
+		if declaredRecord, ok := byKey[managedZoneRecordKey(record.Name, record.Type, value)]; ok {
+			// Keep the configured spelling of records that did not change
+			item.Domain = declaredRecord.Record.Domain
+			item.Type = declaredRecord.Record.Type
+			item.Value = declaredRecord.Record.Value
+			if declaredRecord.Record.TTL.IsNull() && record.TTL == state.TTL.ValueInt64() {
+				item.TTL = types.Int64Null()
+			}
+			current = append(current, item)
+			continue
+		}
+
+		if imported {
+			if record.TTL == state.TTL.ValueInt64() {
+				item.TTL = types.Int64Null()
+			}
+			current = append(current, item)
+			continue
+		}
+
+		unmanaged = append(unmanaged, item)
+	}
+
+	state.Records, diags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: dnsManagedZoneRecordAttrTypes}, current)
+	resp.Diagnostics.Append(diags...)
+	state.UnmanagedRecords, diags = types.SetValueFrom(ctx, types.ObjectType{AttrTypes: dnsManagedZoneRecordAttrTypes}, unmanaged)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsZoneRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+
+	var plan dnsManagedZoneRecords
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.ReconcileRecords(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating zone records",
+			"Could not reconcile the records of zone "+plan.Zone.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ReconcileRecords deletes the records of the zone that are not planned, adds the planned records that are missing and
+// applies the TTL to the records that are kept. Records are deleted first so that a record can replace a conflicting one.
+func (r *dnsZoneRecordsResource) ReconcileRecords(ctx context.Context, plan dnsManagedZoneRecords) error {
+
+	planned, diags := managedZoneRecords(ctx, plan)
+	if diags.HasError() {
+		return fmt.Errorf("invalid zone records")
+	}
+
+	records, err := r.client.GetDnsZoneRecords(plan.Zone.ValueString(), ctx)
+	if err != nil {
+		return err
+	}
+
+	byKey := make(map[string]bool, len(planned))
+	for _, record := range planned {
+		byKey[record.Key] = true
+	}
+
+	live := make(map[string]technitium.DnsZoneRecord, len(records))
+	for _, record := range records {
+		if !isManagedZoneRecord(record, plan) {
+			continue
+		}
+
+		key := managedZoneRecordKey(record.Name, record.Type, technitium.RecordValue(record))
+		if byKey[key] {
+			live[key] = record
+			continue
+		}
+
+		tflog.Debug(ctx, "Deleting unmanaged record "+key)
+		deleted := technitium.NewDnsZoneRecordCreate(plan.Zone.ValueString(), record.Name, record.Type, record.TTL, record.RecordData)
+		err = r.client.DeleteDnsZoneRecord(deleted, ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, record := range planned {
+		liveRecord, ok := live[record.Key]
+		if !ok {
+			tflog.Debug(ctx, "Adding record "+record.Key)
+			err = r.client.CreateDnsZoneRecord(newManagedZoneRecord(plan, record), ctx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if liveRecord.TTL != record.TTL {
+			update := technitium.NewDnsZoneRecordUpdate(newManagedZoneRecord(plan, record))
+			update.Domain = liveRecord.Name
+			update.Comments = liveRecord.Comments
+			update.ExpiryTTL = liveRecord.ExpiryTTL
+			update.Disable = liveRecord.Disabled
+			err = r.client.UpdateDnsZoneRecord(update, ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Delete deletes the declared records and removes the Terraform state on success.
+func (r *dnsZoneRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+
+	var state dnsManagedZoneRecords
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, diags := managedZoneRecords(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, record := range records {
+		err := r.client.DeleteDnsZoneRecord(newManagedZoneRecord(state, record), ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting zone records",
+				"Could not delete record "+record.Key+" of zone "+state.Zone.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *dnsZoneRecordsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.RequiresReplace = path.Paths{
+		path.Root("zone"),
+	}
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Every unmanaged record is removed by the apply, which shows them as deleted in the plan
+	empty := types.SetValueMust(types.ObjectType{AttrTypes: dnsManagedZoneRecordAttrTypes}, nil)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("unmanaged_records"), empty)...)
+
+	var plan dnsManagedZoneRecords
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The state has no unmanaged records before the first apply, so the records to delete are listed in a warning
+	unmanaged := r.UnmanagedRecords(ctx, plan)
+	if len(unmanaged) > 0 {
+		resp.Diagnostics.AddAttributeWarning(path.Root("records"), "Unmanaged records will be deleted",
+			"The following records of zone "+plan.Zone.ValueString()+" are not declared and will be deleted:\n  "+strings.Join(unmanaged, "\n  "))
+	}
+}
+
+// UnmanagedRecords returns the records of the zone that would be deleted by applying the plan. Nothing is returned
+// while the zone or the declared records are unknown.
+func (r *dnsZoneRecordsResource) UnmanagedRecords(ctx context.Context, plan dnsManagedZoneRecords) []string {
+
+	if r.client == nil || plan.Zone.IsUnknown() || plan.KeepApexNs.IsUnknown() || plan.Records.IsUnknown() || plan.Records.IsNull() {
+		return nil
+	}
+
+	var items []dnsManagedZoneRecord
+	if plan.Records.ElementsAs(ctx, &items, false).HasError() {
+		return nil
+	}
+	for _, item := range items {
+		if item.Domain.IsUnknown() || item.Type.IsUnknown() || item.Value.IsUnknown() {
+			return nil
+		}
+	}
+
+	planned, diags := managedZoneRecords(ctx, plan)
+	if diags.HasError() {
+		return nil
+	}
+
+	records, err := r.client.GetDnsZoneRecords(plan.Zone.ValueString(), ctx)
+	if err != nil {
+		// A zone created by the same apply has no records to delete
+		tflog.Debug(ctx, "Could not read the records of zone "+plan.Zone.ValueString()+": "+err.Error())
+		return nil
+	}
+
+	byKey := make(map[string]bool, len(planned))
+	for _, record := range planned {
+		byKey[record.Key] = true
+	}
+
+	var unmanaged []string
+	for _, record := range records {
+		if !isManagedZoneRecord(record, plan) {
+			continue
+		}
+		key := managedZoneRecordKey(record.Name, record.Type, technitium.RecordValue(record))
+		if !byKey[key] {
+			unmanaged = append(unmanaged, key)
+		}
+	}
+
+	return unmanaged
+}
+
+// ImportState imports the records of a zone using its name as the identifier. Every record of the zone is imported as
+// a declared record.
+func (r *dnsZoneRecordsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+
+	state := dnsManagedZoneRecords{
+		Zone:             types.StringValue(req.ID),
+		TTL:              types.Int64Value(3600),
+		KeepApexNs:       types.BoolValue(true),
+		Records:          types.SetNull(types.ObjectType{AttrTypes: dnsManagedZoneRecordAttrTypes}),
+		UnmanagedRecords: types.SetNull(types.ObjectType{AttrTypes: dnsManagedZoneRecordAttrTypes}),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// managedZoneRecord is a declared record of the zone records resource with its parsed record data.
+type managedZoneRecord struct {
+	Record dnsManagedZoneRecord
+	Key    string
+	TTL    int64
+	Data   technitium.DnsZoneRecordData
+}
+
+func managedZoneRecords(ctx context.Context, m dnsManagedZoneRecords) ([]managedZoneRecord, diag.Diagnostics) {
+
+	var items []dnsManagedZoneRecord
+	diags := m.Records.ElementsAs(ctx, &items, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	records := make([]managedZoneRecord, 0, len(items))
+	for _, item := range items {
+		recordType := strings.ToUpper(item.Type.ValueString())
+		data, err := technitium.ParseRecordValue(recordType, item.Value.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("records"), "Invalid record value", err.Error())
+			continue
+		}
+
+		ttl := m.TTL.ValueInt64()
+		if !item.TTL.IsNull() {
+			ttl = item.TTL.ValueInt64()
+		}
+
+		records = append(records, managedZoneRecord{
+			Record: item,
+			Key:    managedZoneRecordKey(item.Domain.ValueString(), recordType, technitium.FormatRecordValue(recordType, data)),
+			TTL:    ttl,
+			Data:   data,
+		})
+	}
+
+	return records, diags
+}
+
+func newManagedZoneRecord(m dnsManagedZoneRecords, record managedZoneRecord) technitium.DnsZoneRecordCreate {
+	return technitium.NewDnsZoneRecordCreate(m.Zone.ValueString(), record.Record.Domain.ValueString(),
+		strings.ToUpper(record.Record.Type.ValueString()), record.TTL, record.Data)
+}
+
+// managedZoneRecordKey identifies a record by its name, type and normalized value.
+func managedZoneRecordKey(domain string, recordType string, value string) string {
+	return strings.TrimSuffix(strings.ToLower(domain), ".") + " " + strings.ToUpper(recordType) + " " + value
+}
+
+// isManagedZoneRecord reports whether a record of the zone is managed by the resource. Only the types with a zone file
+// form are managed, and the apex NS records when they are not kept.
+func isManagedZoneRecord(record technitium.DnsZoneRecord, m dnsManagedZoneRecords) bool {
+	if technitium.RecordValue(record) == "" {
+		return false
+	}
+	if record.Type == "NS" && m.KeepApexNs.ValueBool() && isZoneApex(record.Name, m.Zone.ValueString()) {
+		return false
+	}
+	return true
+}
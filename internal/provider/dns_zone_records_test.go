@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"terraform-provider-technitium/internal/technitium"
+	"terraform-provider-technitium/internal/test"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDnsZoneRecords(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone_records.tf"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_records.test", "records.#", "4"),
+					resource.TestCheckResourceAttr("technitium_dns_zone_records.test", "unmanaged_records.#", "0"),
+					// The apex NS record created with the zone is kept
+					testCheckZoneRecordCount("example.com", "NS", 1),
+				),
+			},
+			{
+				// Records are read back in their configured spelling
+				Config: GetFileConfig(t, "dns_zone_records.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				// A record added outside Terraform is listed as unmanaged and deleted
+				PreConfig: func() {
+					err := createZoneRecord(context.Background(), technitium.DnsZoneRecordCreate{
+						Domain:    "rogue.example.com",
+						Type:      "A",
+						Zone:      "example.com",
+						TTL:       3600,
+						IPAddress: "192.0.2.66",
+					})
+					if err != nil {
+						t.Fatalf("Error creating DNS zone record: %v", err)
+					}
+				},
+				Config: GetFileConfig(t, "dns_zone_records.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone_records.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testCheckZoneRecordCount("rogue.example.com", "A", 0),
+				),
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_records_updated.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone_records.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("technitium_dns_zone_records.test", "records.#", "4"),
+					testCheckZoneRecordCount("mail.example.com", "A", 0),
+					testCheckZoneRecordCount("example.com", "TXT", 1),
+				),
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_records_updated.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:                         "technitium_dns_zone_records.test",
+				ImportState:                          true,
+				ImportStateId:                        "example.com",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone",
+				// The imported records use the spelling of the server and the default TTL
+				ImportStateVerifyIgnore: []string{"records", "ttl"},
+			},
+		},
+	})
+}
+
+func testCheckZoneRecordCount(domain string, recordType string, expected int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		ctx := context.Background()
+		client, err := testClient(ctx)
+		if err != nil {
+			return err
+		}
+
+		records, err := client.GetDnsZoneRecordSet(domain, recordType, ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(records) != expected {
+			return fmt.Errorf("expected %d %s records for %s, got %d", expected, recordType, domain, len(records))
+		}
+		return nil
+	}
+}
+
+func TestDnsZoneRecords_unmanagedRecords(t *testing.T) {
+	ctx := context.Background()
+
+	server := test.NewTestServer(test.Scenario{
+		ExpectedStatus: http.StatusOK,
+		ExpectedBody: `{"status":"ok","response":{"records":[` +
+			`{"name":"example.com","type":"SOA","ttl":900,"rData":{"primaryNameServer":"ns1.example.com","serial":1}},` +
+			`{"name":"example.com","type":"NS","ttl":3600,"rData":{"nameServer":"ns1.example.com"}},` +
+			`{"name":"www.example.com","type":"A","ttl":3600,"rData":{"ipAddress":"192.0.2.1"}},` +
+			`{"name":"old.example.com","type":"A","ttl":3600,"rData":{"ipAddress":"192.0.2.9"}}]}}`,
+	})
+	defer server.Close()
+
+	r := &dnsZoneRecordsResource{client: &technitium.Client{HostURL: server.URL, HTTPClient: server.Client(), Token: "test"}}
+
+	records, diags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: dnsManagedZoneRecordAttrTypes}, []dnsManagedZoneRecord{
+		{Domain: types.StringValue("WWW.example.com."), Type: types.StringValue("A"), TTL: types.Int64Null(), Value: types.StringValue("192.0.2.1")},
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics %v", diags)
+	}
+
+	plan := dnsManagedZoneRecords{
+		Zone:       types.StringValue("example.com"),
+		TTL:        types.Int64Value(3600),
+		KeepApexNs: types.BoolValue(true),
+		Records:    records,
+	}
+
+	// The records of the zone that are not declared are listed before the first apply deletes them
+	unmanaged := r.UnmanagedRecords(ctx, plan)
+	if !slices.Equal(unmanaged, []string{"old.example.com A 192.0.2.9"}) {
+		t.Errorf("Unexpected unmanaged records %v", unmanaged)
+	}
+
+	plan.KeepApexNs = types.BoolValue(false)
+	unmanaged = r.UnmanagedRecords(ctx, plan)
+	if !slices.Equal(unmanaged, []string{"example.com NS ns1.example.com", "old.example.com A 192.0.2.9"}) {
+		t.Errorf("Unexpected unmanaged records %v", unmanaged)
+	}
+}
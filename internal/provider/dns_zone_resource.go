@@ -3,6 +3,9 @@ package provider
 import (
 	"context"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
 	"terraform-provider-technitium/internal/technitium"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -11,9 +14,10 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource               = &dnsZoneResource{}
-	_ resource.ResourceWithConfigure  = &dnsZoneResource{}
-	_ resource.ResourceWithModifyPlan = &dnsZoneResource{}
+	_ resource.Resource                = &dnsZoneResource{}
+	_ resource.ResourceWithConfigure   = &dnsZoneResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsZoneResource{}
+	_ resource.ResourceWithImportState = &dnsZoneResource{}
 )
 
 func NewDnsZoneResource() resource.Resource {
@@ -71,6 +75,109 @@ func (r *dnsZoneResource) Create(ctx context.Context, req resource.CreateRequest
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *dnsZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 
+	var plan dnsZoneCreate
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state dnsZoneCreate
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.UpdateZone(ctx, state, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating DNS zone",
+			"Could not update DNS zone "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// UpdateZone applies the attributes that can be changed without recreating the zone.
+func (r *dnsZoneResource) UpdateZone(ctx context.Context, state dnsZoneCreate, plan dnsZoneCreate) error {
+
+	options := technitium.DnsZoneOptions{
+		Zone: state.Name.ValueString(),
+	}
+	changed := false
+
+	if !plan.Type.Equal(state.Type) {
+		err := r.client.ConvertDnsZone(state.Name.ValueString(), plan.Type.ValueString(), ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !plan.Catalog.Equal(state.Catalog) {
+		// An empty catalog name removes the zone from its catalog
+		catalog := plan.Catalog.ValueString()
+		options.Catalog = &catalog
+		changed = true
+	}
+
+	// Zones converted to a type without primary name servers have nothing to update
+	if usesPrimaryNameServers(plan.Type.ValueString()) {
+		planAddresses := convertTfListToStringList(plan.PrimaryNameServerAddresses)
+		if strings.Join(planAddresses, ",") != strings.Join(convertTfListToStringList(state.PrimaryNameServerAddresses), ",") {
+			// A non-nil empty list tells the API to clear the addresses
+			options.PrimaryNameServerAddresses = append([]string{}, planAddresses...)
+			changed = true
+		}
+
+		if !plan.ZoneTransferProtocol.Equal(state.ZoneTransferProtocol) {
+			// The server ignores an empty protocol, so removing it restores the server default
+			protocol := defaultZoneTransferProtocol
+			if !plan.ZoneTransferProtocol.IsNull() {
+				protocol = plan.ZoneTransferProtocol.ValueString()
+			}
+			options.PrimaryZoneTransferProtocol = &protocol
+			changed = true
+		}
+
+		if !plan.TsigKeyName.Equal(state.TsigKeyName) {
+			// An empty key name stops signing zone transfers
+			tsigKeyName := plan.TsigKeyName.ValueString()
+			options.PrimaryZoneTransferTsigKeyName = &tsigKeyName
+			changed = true
+		}
+	}
+
+	if changed {
+		err := r.client.SetDnsZoneOptions(options, ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if plan.Disabled.ValueBool() != state.Disabled.ValueBool() {
+		var err error
+		if plan.Disabled.ValueBool() {
+			err = r.client.DisableDnsZone(state.Name.ValueString(), ctx)
+		} else {
+			err = r.client.EnableDnsZone(state.Name.ValueString(), ctx)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if !plan.ResyncTrigger.IsNull() && !plan.ResyncTrigger.Equal(state.ResyncTrigger) {
+		return r.client.ResyncDnsZone(state.Name.ValueString(), ctx)
+	}
+
+	return nil
 }
 
 func (r *dnsZoneResource) CreateZone(plan dnsZoneCreate, ctx context.Context) error {
@@ -95,12 +202,115 @@ func (r *dnsZoneResource) CreateZone(plan dnsZoneCreate, ctx context.Context) er
 		return err
 	}
 
+	if plan.Disabled.ValueBool() {
+		return r.client.DisableDnsZone(zone.Name, ctx)
+	}
+
 	return nil
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *dnsZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 
+	var state dnsZoneCreate
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := r.client.GetDnsZone(state.Name.ValueString(), ctx)
+	if technitium.IsZoneNotFound(err) {
+		tflog.Info(ctx, "Removing zone "+state.Name.ValueString()+" from state due to error: "+err.Error())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS zone",
+			"Could not read DNS zone "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Type = types.StringValue(zone.Type)
+	state.Disabled = types.BoolValue(zone.Disabled)
+
+	if zone.Catalog != "" || !state.Catalog.IsNull() {
+		state.Catalog = types.StringValue(zone.Catalog)
+	}
+
+	if len(zone.PrimaryNameServerAddresses) > 0 || state.PrimaryNameServerAddresses != nil {
+		state.PrimaryNameServerAddresses = convertStringListToTF(zone.PrimaryNameServerAddresses)
+	}
+
+	// The server always reports a transfer protocol for secondary zones, so an unset
+	// attribute is only refreshed when the zone no longer uses the default protocol.
+	if !state.ZoneTransferProtocol.IsNull() ||
+		(zone.PrimaryZoneTransferProtocol != "" && !strings.EqualFold(zone.PrimaryZoneTransferProtocol, defaultZoneTransferProtocol)) {
+		state.ZoneTransferProtocol = types.StringValue(zone.PrimaryZoneTransferProtocol)
+	}
+
+	if zone.PrimaryZoneTransferTsigKeyName != "" || !state.TsigKeyName.IsNull() {
+		state.TsigKeyName = types.StringValue(zone.PrimaryZoneTransferTsigKeyName)
+	}
+
+	// Imported zones have no value for attributes that are only used at creation
+	if state.InitializeForwarder.IsNull() {
+		state.InitializeForwarder = types.BoolValue(true)
+	}
+
+	err = r.readZoneRecordSettings(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS zone",
+			"Could not read the records of DNS zone "+state.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// readZoneRecordSettings refreshes the attributes that the server keeps in the apex records of the zone instead of
+// its options: the SOA serial date scheme and the forwarder of a Conditional Forwarder zone. These attributes
+// force a new zone, so they are only left unset when the server has its default value.
+func (r *dnsZoneResource) readZoneRecordSettings(ctx context.Context, state *dnsZoneCreate) error {
+
+	records, err := r.client.GetDnsZoneRecords(state.Name.ValueString(), ctx)
+	if err != nil {
+		return err
+	}
+
+	forwarderFound := false
+	for _, record := range records {
+		if !isZoneApex(record.Name, state.Name.ValueString()) {
+			continue
+		}
+
+		switch record.Type {
+		case "SOA":
+			setOptionalBool(&state.UseSoaSerialDateScheme, record.RecordData.UseSerialDateScheme)
+		case "FWD":
+			// Only the first forwarder was set when the zone was created
+			if forwarderFound || state.Type.ValueString() != "Forwarder" {
+				continue
+			}
+			forwarderFound = true
+
+			setOptionalString(&state.Forwarder, record.RecordData.Forwarder, strings.EqualFold)
+			if !state.Protocol.IsNull() || !strings.EqualFold(record.RecordData.Protocol, defaultForwarderProtocol) {
+				setStringIgnoringCase(&state.Protocol, record.RecordData.Protocol)
+			}
+			setOptionalBool(&state.DnssecValidation, record.RecordData.DnssecValidation)
+		}
+	}
+
+	return nil
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -121,18 +331,48 @@ func (r *dnsZoneResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
-func (r *dnsZoneResource) ModifyPlan(_ context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+func (r *dnsZoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	resp.RequiresReplace = path.Paths{
 		path.Root("name"),
-		path.Root("type"),
-		path.Root("catalog"),
 		path.Root("forwarder"),
 		path.Root("use_soa_serial_date_scheme"),
-		path.Root("primary_name_server_addresses"),
-		path.Root("zone_transfer_protocol"),
-		path.Root("tsig_key_name"),
 		path.Root("protocol"),
 		path.Root("dnssec_validation"),
 	}
 
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state dnsZoneCreate
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the type transitions supported by the zone conversion API are applied in place
+	if !plan.Type.IsUnknown() && !plan.Type.Equal(state.Type) &&
+		!technitium.CanConvertDnsZone(state.Type.ValueString(), plan.Type.ValueString()) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("type"))
+	}
+}
+
+// ImportState imports an existing zone using its name as the identifier.
+func (r *dnsZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+// defaultForwarderProtocol is the protocol of the forwarder of a Conditional Forwarder zone when none is set.
+const defaultForwarderProtocol = "Udp"
+
+// defaultZoneTransferProtocol is the protocol the server uses for zone transfers when none is set.
+const defaultZoneTransferProtocol = "Tcp"
+
+func usesPrimaryNameServers(zoneType string) bool {
+	switch zoneType {
+	case "Secondary", "Stub", "SecondaryForwarder", "SecondaryCatalog":
+		return true
+	}
+	return false
 }
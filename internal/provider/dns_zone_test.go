@@ -1,9 +1,19 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"terraform-provider-technitium/internal/technitium"
+	"terraform-provider-technitium/internal/test"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestDnsZones(t *testing.T) {
@@ -17,6 +27,163 @@ func TestDnsZones(t *testing.T) {
 					"technitium_dns_zone.test", "name", "example.com",
 				),
 			},
+			{
+				ResourceName:                         "technitium_dns_zone.test",
+				ImportState:                          true,
+				ImportStateId:                        "example.com",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			{
+				// Disabling the zone must not recreate it
+				Config: GetFileConfig(t, "dns_zone_disabled.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("technitium_dns_zone.test", "disabled", "true"),
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone.tf"),
+				Check:  resource.TestCheckResourceAttr("technitium_dns_zone.test", "disabled", "false"),
+			},
+		},
+	})
+}
+
+func TestAccDnsZone_convert(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone.tf"),
+			},
+			{
+				// Primary to Forwarder is converted in place
+				Config: GetFileConfig(t, "dns_zone_forwarder.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("technitium_dns_zone.test", "type", "Forwarder"),
+			},
+			{
+				// Forwarder to Stub is not supported by the server
+				Config: GetFileConfig(t, "dns_zone_stub.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("technitium_dns_zone.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDnsZone_forwarderImport(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: GetFileConfig(t, "dns_zone_conditional_forwarder.tf"),
+			},
+			{
+				// The forwarder settings force a new zone, so they must be read back on import
+				ResourceName:                         "technitium_dns_zone.test",
+				ImportState:                          true,
+				ImportStateId:                        "forwarded.example.com",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			{
+				Config: GetFileConfig(t, "dns_zone_conditional_forwarder.tf"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
 		},
 	})
 }
+
+func TestDnsZone_readError(t *testing.T) {
+
+	server := test.NewTestServer(test.Scenario{
+		ExpectedStatus: http.StatusOK,
+		ExpectedBody:   `{"status":"error","errorMessage":"The server is busy"}`,
+	})
+	defer server.Close()
+
+	config := fmt.Sprintf(`
+provider "technitium" {
+  host = "%s"
+  token = "test"
+}
+
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+`, server.URL)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Errors other than a missing zone are reported instead of planning a new zone
+				Config:        config,
+				ResourceName:  "technitium_dns_zone.test",
+				ImportState:   true,
+				ImportStateId: "example.com",
+				ExpectError:   regexp.MustCompile("Could not read DNS zone example.com"),
+			},
+		},
+	})
+}
+
+func TestDnsZone_resyncTrigger(t *testing.T) {
+	ctx := context.Background()
+
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		fmt.Fprint(w, `{"status":"ok"}`)
+	}))
+	defer server.Close()
+
+	r := &dnsZoneResource{client: &technitium.Client{HostURL: server.URL, HTTPClient: server.Client(), Token: "test"}}
+
+	state := dnsZoneCreate{
+		dnsZone: dnsZone{
+			Name:    types.StringValue("secondary.example"),
+			Type:    types.StringValue("Secondary"),
+			Catalog: types.StringNull(),
+		},
+		Disabled:                   types.BoolValue(false),
+		PrimaryNameServerAddresses: []types.String{types.StringValue("192.0.2.53")},
+		ZoneTransferProtocol:       types.StringNull(),
+		TsigKeyName:                types.StringNull(),
+		ResyncTrigger:              types.StringValue("1"),
+	}
+
+	// An unchanged trigger does not resync the zone
+	if err := r.UpdateZone(ctx, state, state); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(paths) != 0 {
+		t.Errorf("Expected no requests, got %v", paths)
+	}
+
+	plan := state
+	plan.ResyncTrigger = types.StringValue("2")
+	if err := r.UpdateZone(ctx, state, plan); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !slices.Equal(paths, []string{"/api/zones/resync"}) {
+		t.Errorf("Expected a resync request, got %v", paths)
+	}
+}
@@ -24,7 +24,9 @@ type dnsZonesDataSource struct {
 	client *technitium.Client
 }
 type dnsZonesDataSourceModel struct {
-	Zones []dnsZoneList `tfsdk:"zones"`
+	PageSize   types.Int64   `tfsdk:"page_size"`
+	TotalZones types.Int64   `tfsdk:"total_zones"`
+	Zones      []dnsZoneList `tfsdk:"zones"`
 }
 
 // Metadata returns the data source type name.
@@ -36,6 +38,14 @@ func (d *dnsZonesDataSource) Metadata(_ context.Context, req datasource.Metadata
 func (d *dnsZonesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"page_size": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The number of zones requested per page while listing all zones. Default is %d.", technitium.DefaultZonesPerPage),
+			},
+			"total_zones": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The total number of zones.",
+			},
 			"zones": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -49,8 +59,13 @@ func (d *dnsZonesDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 // Read refreshes the Terraform state with the latest data.
 func (d *dnsZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state dnsZonesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	zones, err := d.client.GetDnsZones(ctx)
+	zones, err := d.client.GetDnsZones(int(state.PageSize.ValueInt64()), ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Read DNS Zones",
@@ -75,9 +90,10 @@ func (d *dnsZonesDataSource) Read(ctx context.Context, req datasource.ReadReques
 
 		state.Zones = append(state.Zones, zoneState)
 	}
+	state.TotalZones = types.Int64Value(int64(len(zones)))
 
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -39,6 +39,7 @@ provider "technitium" {
 					resource.TestCheckResourceAttr("data.technitium_dns_zones.test", "zones.0.is_expired", "false"),
 					resource.TestCheckResourceAttr("data.technitium_dns_zones.test", "zones.0.internal", "true"),
 					resource.TestCheckResourceAttr("data.technitium_dns_zones.test", "zones.#", "6"),
+					resource.TestCheckResourceAttr("data.technitium_dns_zones.test", "total_zones", "6"),
 
 					resource.TestCheckResourceAttrWith(
 						"data.technitium_dns_zones.test",
@@ -1,6 +1,9 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type dhcpScopeList struct {
 	Name             types.String `tfsdk:"name"`
@@ -43,6 +46,22 @@ type dnsZone struct {
 	Catalog types.String `tfsdk:"catalog"`
 }
 
+type dnsSecondaryZoneStatus struct {
+	Zone         types.String `tfsdk:"zone"`
+	Type         types.String `tfsdk:"type"`
+	Disabled     types.Bool   `tfsdk:"disabled"`
+	SoaSerial    types.Int64  `tfsdk:"soa_serial"`
+	Expiry       types.String `tfsdk:"expiry"`
+	IsExpired    types.Bool   `tfsdk:"is_expired"`
+	SyncFailed   types.Bool   `tfsdk:"sync_failed"`
+	NotifyFailed types.Bool   `tfsdk:"notify_failed"`
+}
+
+type dnsZoneExport struct {
+	Zone    types.String `tfsdk:"zone"`
+	Content types.String `tfsdk:"content"`
+}
+
 type dnsZoneGet struct {
 	dnsZone
 	Disabled                 types.Bool     `tfsdk:"disabled"`
@@ -73,6 +92,7 @@ type dnsZoneList struct {
 
 type dnsZoneCreate struct {
 	dnsZone
+	Disabled                   types.Bool     `tfsdk:"disabled"`
 	Forwarder                  types.String   `tfsdk:"forwarder"`
 	InitializeForwarder        types.Bool     `tfsdk:"initialize_forwarder"`
 	UseSoaSerialDateScheme     types.Bool     `tfsdk:"use_soa_serial_date_scheme"`
@@ -81,6 +101,117 @@ type dnsZoneCreate struct {
 	TsigKeyName                types.String   `tfsdk:"tsig_key_name"`
 	Protocol                   types.String   `tfsdk:"protocol"`
 	DnssecValidation           types.Bool     `tfsdk:"dnssec_validation"`
+	ResyncTrigger              types.String   `tfsdk:"resync_trigger"`
+}
+
+type dnsCatalogZone struct {
+	Name    types.String `tfsdk:"name"`
+	Members types.List   `tfsdk:"members"`
+}
+
+type dnsZoneClone struct {
+	Name       types.String `tfsdk:"name"`
+	SourceZone types.String `tfsdk:"source_zone"`
+	Type       types.String `tfsdk:"type"`
+}
+
+type dnsZoneFile struct {
+	Zone               types.String `tfsdk:"zone"`
+	Content            types.String `tfsdk:"content"`
+	Overwrite          types.Bool   `tfsdk:"overwrite"`
+	OverwriteSoaSerial types.Bool   `tfsdk:"overwrite_soa_serial"`
+}
+
+type dnsZoneOptions struct {
+	Zone                     types.String `tfsdk:"zone"`
+	QueryAccess              types.String `tfsdk:"query_access"`
+	QueryAccessNetworkAcl    types.List   `tfsdk:"query_access_network_acl"`
+	ZoneTransfer             types.String `tfsdk:"zone_transfer"`
+	ZoneTransferNetworkAcl   types.List   `tfsdk:"zone_transfer_network_acl"`
+	ZoneTransferTsigKeyNames types.List   `tfsdk:"zone_transfer_tsig_key_names"`
+	Notify                   types.String `tfsdk:"notify"`
+	NotifyNameServers        types.List   `tfsdk:"notify_name_servers"`
+	Update                   types.String `tfsdk:"update"`
+	UpdateNetworkAcl         types.List   `tfsdk:"update_network_acl"`
+}
+
+type dnsZoneDnssec struct {
+	Zone               types.String `tfsdk:"zone"`
+	Algorithm          types.String `tfsdk:"algorithm"`
+	HashAlgorithm      types.String `tfsdk:"hash_algorithm"`
+	KskKeySize         types.Int64  `tfsdk:"ksk_key_size"`
+	ZskKeySize         types.Int64  `tfsdk:"zsk_key_size"`
+	Curve              types.String `tfsdk:"curve"`
+	NxProof            types.String `tfsdk:"nx_proof"`
+	Iterations         types.Int64  `tfsdk:"iterations"`
+	SaltLength         types.Int64  `tfsdk:"salt_length"`
+	DnsKeyTTL          types.Int64  `tfsdk:"dns_key_ttl"`
+	ZskRolloverDays    types.Int64  `tfsdk:"zsk_rollover_days"`
+	KskRolloverTrigger types.String `tfsdk:"ksk_rollover_trigger"`
+	ZskRolloverTrigger types.String `tfsdk:"zsk_rollover_trigger"`
+	DnssecStatus       types.String `tfsdk:"dnssec_status"`
+	DsRecords          types.List   `tfsdk:"ds_records"`
+	PrivateKeys        types.List   `tfsdk:"private_keys"`
+}
+
+type dnsZoneDnssecPrivateKey struct {
+	KeyTag         types.Int64  `tfsdk:"key_tag"`
+	KeyType        types.String `tfsdk:"key_type"`
+	Algorithm      types.String `tfsdk:"algorithm"`
+	State          types.String `tfsdk:"state"`
+	StateChangedOn types.String `tfsdk:"state_changed_on"`
+	StateReadyBy   types.String `tfsdk:"state_ready_by"`
+	IsRetiring     types.Bool   `tfsdk:"is_retiring"`
+	RolloverDays   types.Int64  `tfsdk:"rollover_days"`
+}
+
+var dnsZoneDnssecPrivateKeyAttrTypes = map[string]attr.Type{
+	"key_tag":          types.Int64Type,
+	"key_type":         types.StringType,
+	"algorithm":        types.StringType,
+	"state":            types.StringType,
+	"state_changed_on": types.StringType,
+	"state_ready_by":   types.StringType,
+	"is_retiring":      types.BoolType,
+	"rollover_days":    types.Int64Type,
+}
+
+type dnsZoneDnssecKey struct {
+	Zone            types.String `tfsdk:"zone"`
+	KeyType         types.String `tfsdk:"key_type"`
+	Algorithm       types.String `tfsdk:"algorithm"`
+	HashAlgorithm   types.String `tfsdk:"hash_algorithm"`
+	KeySize         types.Int64  `tfsdk:"key_size"`
+	Curve           types.String `tfsdk:"curve"`
+	RolloverDays    types.Int64  `tfsdk:"rollover_days"`
+	Publish         types.Bool   `tfsdk:"publish"`
+	Retire          types.Bool   `tfsdk:"retire"`
+	KeyTag          types.Int64  `tfsdk:"key_tag"`
+	DnsKeyAlgorithm types.String `tfsdk:"dnskey_algorithm"`
+	State           types.String `tfsdk:"state"`
+	StateChangedOn  types.String `tfsdk:"state_changed_on"`
+	StateReadyBy    types.String `tfsdk:"state_ready_by"`
+	IsRetiring      types.Bool   `tfsdk:"is_retiring"`
+}
+
+type dnsZoneDsRecord struct {
+	KeyTag           types.Int64  `tfsdk:"key_tag"`
+	DnsKeyState      types.String `tfsdk:"dns_key_state"`
+	Algorithm        types.String `tfsdk:"algorithm"`
+	AlgorithmNumber  types.Int64  `tfsdk:"algorithm_number"`
+	DigestType       types.String `tfsdk:"digest_type"`
+	DigestTypeNumber types.Int64  `tfsdk:"digest_type_number"`
+	Digest           types.String `tfsdk:"digest"`
+}
+
+var dnsZoneDsRecordAttrTypes = map[string]attr.Type{
+	"key_tag":            types.Int64Type,
+	"dns_key_state":      types.StringType,
+	"algorithm":          types.StringType,
+	"algorithm_number":   types.Int64Type,
+	"digest_type":        types.StringType,
+	"digest_type_number": types.Int64Type,
+	"digest":             types.StringType,
 }
 
 type dnsZoneRecords struct {
@@ -97,57 +228,164 @@ type dnsZoneRecord struct {
 	LastUsedOn   types.String       `tfsdk:"last_used_on"`
 	LastModified types.String       `tfsdk:"last_modified"`
 	ExpiryTTL    types.Int64        `tfsdk:"expiry_ttl"`
+	Comments     types.String       `tfsdk:"comments"`
 	RecordData   *dnsZoneRecordData `tfsdk:"record_data"`
 }
 
 type dnsZoneRecordData struct {
-	PrimaryNameServer   types.String `tfsdk:"primary_name_server"`
-	ResponsiblePerson   types.String `tfsdk:"responsible_person"`
-	Serial              types.Int64  `tfsdk:"serial"`
-	Refresh             types.Int64  `tfsdk:"refresh"`
-	Retry               types.Int64  `tfsdk:"retry"`
-	Expire              types.Int64  `tfsdk:"expire"`
-	Minimum             types.Int64  `tfsdk:"minimum"`
-	UseSerialDateScheme types.Bool   `tfsdk:"use_serial_date_scheme"`
-	Protocol            types.String `tfsdk:"protocol"`
-	Forwarder           types.String `tfsdk:"forwarder"`
-	Priority            types.Int64  `tfsdk:"priority"`
-	DnssecValidation    types.Bool   `tfsdk:"dnssec_validation"`
-	ProxyType           types.String `tfsdk:"proxy_type"`
-	IpAddress           types.String `tfsdk:"ip_address"`
-	Cname               types.String `tfsdk:"cname"`
-	NameServer          types.String `tfsdk:"name_server"`
+	PrimaryNameServer          types.String `tfsdk:"primary_name_server"`
+	ResponsiblePerson          types.String `tfsdk:"responsible_person"`
+	Serial                     types.Int64  `tfsdk:"serial"`
+	Refresh                    types.Int64  `tfsdk:"refresh"`
+	Retry                      types.Int64  `tfsdk:"retry"`
+	Expire                     types.Int64  `tfsdk:"expire"`
+	Minimum                    types.Int64  `tfsdk:"minimum"`
+	UseSerialDateScheme        types.Bool   `tfsdk:"use_serial_date_scheme"`
+	Protocol                   types.String `tfsdk:"protocol"`
+	Forwarder                  types.String `tfsdk:"forwarder"`
+	Priority                   types.Int64  `tfsdk:"priority"`
+	DnssecValidation           types.Bool   `tfsdk:"dnssec_validation"`
+	ProxyType                  types.String `tfsdk:"proxy_type"`
+	IpAddress                  types.String `tfsdk:"ip_address"`
+	Cname                      types.String `tfsdk:"cname"`
+	NameServer                 types.String `tfsdk:"name_server"`
+	ProxyAddress               types.String `tfsdk:"proxy_address"`
+	ProxyPort                  types.Int64  `tfsdk:"proxy_port"`
+	ProxyUsername              types.String `tfsdk:"proxy_username"`
+	PtrName                    types.String `tfsdk:"ptr_name"`
+	Exchange                   types.String `tfsdk:"exchange"`
+	Preference                 types.Int64  `tfsdk:"preference"`
+	Text                       types.String `tfsdk:"text"`
+	SplitText                  types.Bool   `tfsdk:"split_text"`
+	Weight                     types.Int64  `tfsdk:"weight"`
+	Port                       types.Int64  `tfsdk:"port"`
+	Target                     types.String `tfsdk:"target"`
+	Order                      types.Int64  `tfsdk:"order"`
+	NaptrFlags                 types.String `tfsdk:"naptr_flags"`
+	Services                   types.String `tfsdk:"services"`
+	Regexp                     types.String `tfsdk:"regexp"`
+	Replacement                types.String `tfsdk:"replacement"`
+	Dname                      types.String `tfsdk:"dname"`
+	Aname                      types.String `tfsdk:"aname"`
+	KeyTag                     types.Int64  `tfsdk:"key_tag"`
+	Algorithm                  types.String `tfsdk:"algorithm"`
+	DigestType                 types.String `tfsdk:"digest_type"`
+	Digest                     types.String `tfsdk:"digest"`
+	FingerprintType            types.String `tfsdk:"fingerprint_type"`
+	Fingerprint                types.String `tfsdk:"fingerprint"`
+	CertificateUsage           types.String `tfsdk:"certificate_usage"`
+	Selector                   types.String `tfsdk:"selector"`
+	MatchingType               types.String `tfsdk:"matching_type"`
+	CertificateAssociationData types.String `tfsdk:"certificate_association_data"`
+	SvcPriority                types.Int64  `tfsdk:"svc_priority"`
+	SvcMode                    types.String `tfsdk:"svc_mode"`
+	TargetName                 types.String `tfsdk:"target_name"`
+	SvcParams                  types.Map    `tfsdk:"svc_params"`
+	AutoIpv4Hint               types.Bool   `tfsdk:"auto_ipv4_hint"`
+	AutoIpv6Hint               types.Bool   `tfsdk:"auto_ipv6_hint"`
+	Uri                        types.String `tfsdk:"uri"`
+	Flags                      types.Int64  `tfsdk:"flags"`
+	Tag                        types.String `tfsdk:"tag"`
+	Value                      types.String `tfsdk:"value"`
+	AppName                    types.String `tfsdk:"app_name"`
+	ClassPath                  types.String `tfsdk:"class_path"`
+	Data                       types.String `tfsdk:"data"`
 }
 
 type dnsZoneRecordCreate struct {
-	Domain            types.String `tfsdk:"domain"`
-	Zone              types.String `tfsdk:"zone"`
-	Type              types.String `tfsdk:"type"`
-	Disabled          types.Bool   `tfsdk:"disabled"`
-	TTL               types.Int64  `tfsdk:"ttl"`
-	Comments          types.String `tfsdk:"comments"`
-	ExpiryTTL         types.Int64  `tfsdk:"expiry_ttl"`
-	IPAddress         types.String `tfsdk:"ip_address"`
-	Ptr               types.String `tfsdk:"ptr"`
-	CreatePtrZone     types.Bool   `tfsdk:"create_ptr_zone"`
-	UpdateSvcbHints   types.Bool   `tfsdk:"update_svcb_hints"`
-	NameServer        types.String `tfsdk:"name_server"`
-	Cname             types.String `tfsdk:"cname"`
-	PtrName           types.String `tfsdk:"ptr_name"`
-	Exchange          types.String `tfsdk:"exchange"`
-	Preference        types.Int64  `tfsdk:"preference"`
-	Text              types.String `tfsdk:"text"`
-	SplitText         types.String `tfsdk:"split_text"`
-	Protocol          types.String `tfsdk:"protocol"`
-	Forwarder         types.String `tfsdk:"forwarder"`
-	ForwarderPriority types.Int64  `tfsdk:"forwarder_priority"`
-	DnssecValidation  types.Bool   `tfsdk:"dnssec_validation"`
-	ProxyType         types.String `tfsdk:"proxy_type"`
-	ProxyAddress      types.String `tfsdk:"proxy_address"`
-	ProxyPort         types.Int64  `tfsdk:"proxy_port"`
-	ProxyUsername     types.String `tfsdk:"proxy_username"`
-	ProxyPassword     types.String `tfsdk:"proxy_password"`
-	AppName           types.String `tfsdk:"app_name"`
-	ClassPath         types.String `tfsdk:"class_path"`
-	RecordData        types.String `tfsdk:"record_data"`
+	ID                types.String    `tfsdk:"id"`
+	Domain            types.String    `tfsdk:"domain"`
+	Zone              types.String    `tfsdk:"zone"`
+	Type              types.String    `tfsdk:"type"`
+	Disabled          types.Bool      `tfsdk:"disabled"`
+	TTL               types.Int64     `tfsdk:"ttl"`
+	Comments          types.String    `tfsdk:"comments"`
+	ExpiryTTL         types.Int64     `tfsdk:"expiry_ttl"`
+	IPAddress         types.String    `tfsdk:"ip_address"`
+	Ptr               types.String    `tfsdk:"ptr"`
+	CreatePtrZone     types.Bool      `tfsdk:"create_ptr_zone"`
+	UpdateSvcbHints   types.Bool      `tfsdk:"update_svcb_hints"`
+	NameServer        types.String    `tfsdk:"name_server"`
+	Cname             types.String    `tfsdk:"cname"`
+	Aname             types.String    `tfsdk:"aname"`
+	Dname             types.String    `tfsdk:"dname"`
+	PtrName           types.String    `tfsdk:"ptr_name"`
+	Exchange          types.String    `tfsdk:"exchange"`
+	Preference        types.Int64     `tfsdk:"preference"`
+	Text              types.String    `tfsdk:"text"`
+	SplitText         types.String    `tfsdk:"split_text"`
+	Protocol          types.String    `tfsdk:"protocol"`
+	Forwarder         types.String    `tfsdk:"forwarder"`
+	ForwarderPriority types.Int64     `tfsdk:"forwarder_priority"`
+	DnssecValidation  types.Bool      `tfsdk:"dnssec_validation"`
+	ProxyType         types.String    `tfsdk:"proxy_type"`
+	ProxyAddress      types.String    `tfsdk:"proxy_address"`
+	ProxyPort         types.Int64     `tfsdk:"proxy_port"`
+	ProxyUsername     types.String    `tfsdk:"proxy_username"`
+	ProxyPassword     types.String    `tfsdk:"proxy_password"`
+	AppName           types.String    `tfsdk:"app_name"`
+	ClassPath         types.String    `tfsdk:"class_path"`
+	RecordData        jsonStringValue `tfsdk:"record_data"`
+	Priority          types.Int64     `tfsdk:"priority"`
+	Weight            types.Int64     `tfsdk:"weight"`
+	Port              types.Int64     `tfsdk:"port"`
+	Target            types.String    `tfsdk:"target"`
+	Flags             types.Int64     `tfsdk:"flags"`
+	Tag               types.String    `tfsdk:"tag"`
+	Value             types.String    `tfsdk:"value"`
+	SvcPriority       types.Int64     `tfsdk:"svc_priority"`
+	SvcTargetName     types.String    `tfsdk:"svc_target_name"`
+	SvcParams         types.Map       `tfsdk:"svc_params"`
+
+	KeyTag                         types.Int64  `tfsdk:"key_tag"`
+	Algorithm                      types.String `tfsdk:"algorithm"`
+	DigestType                     types.String `tfsdk:"digest_type"`
+	Digest                         types.String `tfsdk:"digest"`
+	SshfpAlgorithm                 types.String `tfsdk:"sshfp_algorithm"`
+	SshfpFingerprintType           types.String `tfsdk:"sshfp_fingerprint_type"`
+	SshfpFingerprint               types.String `tfsdk:"sshfp_fingerprint"`
+	TlsaCertificateUsage           types.String `tfsdk:"tlsa_certificate_usage"`
+	TlsaSelector                   types.String `tfsdk:"tlsa_selector"`
+	TlsaMatchingType               types.String `tfsdk:"tlsa_matching_type"`
+	TlsaCertificateAssociationData types.String `tfsdk:"tlsa_certificate_association_data"`
+
+	NaptrOrder       types.Int64  `tfsdk:"naptr_order"`
+	NaptrPreference  types.Int64  `tfsdk:"naptr_preference"`
+	NaptrFlags       types.String `tfsdk:"naptr_flags"`
+	NaptrServices    types.String `tfsdk:"naptr_services"`
+	NaptrRegexp      types.String `tfsdk:"naptr_regexp"`
+	NaptrReplacement types.String `tfsdk:"naptr_replacement"`
+	UriPriority      types.Int64  `tfsdk:"uri_priority"`
+	UriWeight        types.Int64  `tfsdk:"uri_weight"`
+	Uri              types.String `tfsdk:"uri"`
+}
+
+type dnsManagedZoneRecords struct {
+	Zone             types.String `tfsdk:"zone"`
+	TTL              types.Int64  `tfsdk:"ttl"`
+	KeepApexNs       types.Bool   `tfsdk:"keep_apex_ns"`
+	Records          types.Set    `tfsdk:"records"`
+	UnmanagedRecords types.Set    `tfsdk:"unmanaged_records"`
+}
+
+type dnsManagedZoneRecord struct {
+	Domain types.String `tfsdk:"domain"`
+	Type   types.String `tfsdk:"type"`
+	TTL    types.Int64  `tfsdk:"ttl"`
+	Value  types.String `tfsdk:"value"`
+}
+
+var dnsManagedZoneRecordAttrTypes = map[string]attr.Type{
+	"domain": types.StringType,
+	"type":   types.StringType,
+	"ttl":    types.Int64Type,
+	"value":  types.StringType,
+}
+
+type dnsRecordSet struct {
+	Zone   types.String `tfsdk:"zone"`
+	Domain types.String `tfsdk:"domain"`
+	Type   types.String `tfsdk:"type"`
+	TTL    types.Int64  `tfsdk:"ttl"`
+	Values types.Set    `tfsdk:"values"`
 }
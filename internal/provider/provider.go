@@ -210,6 +210,8 @@ func (p *technitiumProvider) DataSources(_ context.Context) []func() datasource.
 		NewDhcpScopesDataSource,
 		NewDnsZoneDataSource,
 		NewDnsZonesDataSource,
+		NewDnsZoneExportDataSource,
+		NewDnsSecondaryZoneStatusDataSource,
 		NewDnsZoneRecordDataSource,
 		NewDnsZoneRecordsDataSource,
 	}
@@ -221,5 +223,13 @@ func (p *technitiumProvider) Resources(_ context.Context) []func() resource.Reso
 		NewDhcpReservedLeaseResource,
 		NewDnsZoneResource,
 		NewDnsZoneRecordResource,
+		NewDnsRecordSetResource,
+		NewDnsZoneRecordsResource,
+		NewDnsZoneOptionsResource,
+		NewDnsZoneDnssecResource,
+		NewDnsZoneDnssecKeyResource,
+		NewDnsZoneFileResource,
+		NewDnsZoneCloneResource,
+		NewDnsCatalogZoneResource,
 	}
 }
@@ -1,10 +1,16 @@
 package provider
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -80,10 +86,19 @@ func DnsZoneResourceSchema() map[string]schema.Attribute {
 		"type": schema.StringAttribute{
 			Required: true,
 			Description: "The type of DNS zone. Valid values are " +
-				"[`Primary`, `Secondary`, `Stub`, `Forwarder`, `SecondaryForwarder`, `Catalog`, `SecondaryCatalog`]",
+				"[`Primary`, `Secondary`, `Stub`, `Forwarder`, `SecondaryForwarder`, `Catalog`, `SecondaryCatalog`]. " +
+				"Converting `Secondary` to `Primary` or `Forwarder`, `Primary` to `Forwarder`, `Forwarder` to `Primary` " +
+				"and `SecondaryForwarder` to `Forwarder` keeps the records, any other change recreates the zone.",
 		},
 		"catalog": schema.StringAttribute{
-			Optional: true,
+			Optional:    true,
+			Description: "The name of the catalog zone the zone is a member of. Changing it moves the zone to the new catalog in place.",
+		},
+		"disabled": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Set to true to disable the zone without deleting it or its records. Default is false.",
 		},
 		"forwarder": schema.StringAttribute{
 			Optional: true,
@@ -125,11 +140,498 @@ func DnsZoneResourceSchema() map[string]schema.Attribute {
 		"dnssec_validation": schema.BoolAttribute{
 			Optional: true,
 		},
+		"resync_trigger": schema.StringAttribute{
+			Optional: true,
+			Description: "Any value. Changing it resynchronizes a `Secondary`, `Stub`, `SecondaryForwarder` or " +
+				"`SecondaryCatalog` zone with its primary name servers.",
+		},
+	}
+}
+
+// emptyStringList is the default of list attributes that are cleared on the server when they are not configured.
+var emptyStringList = types.ListValueMust(types.StringType, []attr.Value{})
+
+func DnsZoneOptionsResourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"zone": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the zone to configure.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"query_access": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "Who is allowed to query the zone. Valid values are " +
+				"[`Deny`, `Allow`, `AllowOnlyPrivateNetworks`, `AllowOnlyZoneNameServers`, `UseSpecifiedNetworkACL`, `AllowZoneNameServersAndUseSpecifiedNetworkACL`].",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"query_access_network_acl": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Default:     listdefault.StaticValue(emptyStringList),
+			Description: "Network ACL entries used when `query_access` uses a specified network ACL. " +
+				"Prefix an entry with `!` to deny it, e.g. `!192.168.10.0/24`.",
+		},
+		"zone_transfer": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "Who is allowed to transfer the zone (AXFR/IXFR). Valid values are " +
+				"[`Deny`, `Allow`, `AllowOnlyZoneNameServers`, `UseSpecifiedNetworkACL`, `AllowZoneNameServersAndUseSpecifiedNetworkACL`].",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"zone_transfer_network_acl": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Default:     listdefault.StaticValue(emptyStringList),
+			Description: "Network ACL entries used when `zone_transfer` uses a specified network ACL.",
+		},
+		"zone_transfer_tsig_key_names": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Default:     listdefault.StaticValue(emptyStringList),
+			Description: "The TSIG key names that are allowed to transfer the zone.",
+		},
+		"notify": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "Which name servers are sent NOTIFY messages when the zone changes. Valid values are " +
+				"[`None`, `ZoneNameServers`, `SpecifiedNameServers`, `BothZoneAndSpecifiedNameServers`, `SeparateNameServersForCatalogAndMemberZones`].",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"notify_name_servers": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Default:     listdefault.StaticValue(emptyStringList),
+			Description: "The IP addresses of the name servers to notify when `notify` uses specified name servers.",
+		},
+		"update": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Description: "Who is allowed to send dynamic updates (RFC 2136) to the zone. Valid values are " +
+				"[`Deny`, `Allow`, `AllowOnlyZoneNameServers`, `UseSpecifiedNetworkACL`, `AllowZoneNameServersAndUseSpecifiedNetworkACL`].",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"update_network_acl": schema.ListAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Default:     listdefault.StaticValue(emptyStringList),
+			Description: "Network ACL entries used when `update` uses a specified network ACL.",
+		},
+	}
+}
+
+func DnsZoneDnssecResourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"zone": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the primary zone to sign.",
+		},
+		"algorithm": schema.StringAttribute{
+			Required:    true,
+			Description: "The signing algorithm. Valid values are [`RSA`, `ECDSA`, `EDDSA`]. Changing it re-signs the zone.",
+		},
+		"hash_algorithm": schema.StringAttribute{
+			Optional:    true,
+			Description: "The hash algorithm used with `RSA`. Valid values are [`MD5`, `SHA1`, `SHA256`, `SHA512`].",
+		},
+		"ksk_key_size": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The size in bits of the Key Signing Key when `algorithm` is `RSA`.",
+		},
+		"zsk_key_size": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The size in bits of the Zone Signing Key when `algorithm` is `RSA`.",
+		},
+		"curve": schema.StringAttribute{
+			Optional: true,
+			Description: "The curve used with `ECDSA` or `EDDSA`. Valid values are [`P256`, `P384`] for `ECDSA` " +
+				"and [`ED25519`, `ED448`] for `EDDSA`.",
+		},
+		"nx_proof": schema.StringAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("NSEC"),
+			Description: "The proof of non-existence used by the signed zone. Valid values are [`NSEC`, `NSEC3`]. Default is `NSEC`.",
+		},
+		"iterations": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			Description: "The number of NSEC3 hash iterations. Only used when `nx_proof` is `NSEC3`. Default is 0.",
+		},
+		"salt_length": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+			Description: "The length in bytes of the NSEC3 salt. Only used when `nx_proof` is `NSEC3`. Default is 0.",
+		},
+		"dns_key_ttl": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(3600),
+			Description: "The TTL in seconds of the DNSKEY records. Default is 3600.",
+		},
+		"zsk_rollover_days": schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(30),
+			Description: "The number of days after which the Zone Signing Keys are automatically rolled over. Set to 0 to disable automatic rollover. Default is 30. " +
+				"Changing it only updates the keys that still have the previous period, so keys given their own `rollover_days` by " +
+				"`technitium_dns_zone_dnssec_key` keep it.",
+		},
+		"ksk_rollover_trigger": schema.StringAttribute{
+			Optional: true,
+			Description: "Any value. Changing it rolls over every active Key Signing Key of the zone. " +
+				"The new DS records must be published at the parent zone before the old keys are retired.",
+		},
+		"zsk_rollover_trigger": schema.StringAttribute{
+			Optional:    true,
+			Description: "Any value. Changing it rolls over every active Zone Signing Key of the zone.",
+		},
+		"dnssec_status": schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"private_keys": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The private keys of the zone and their current state.",
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: DnssecPrivateKeySchema(),
+			},
+		},
+		"ds_records": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The DS records to publish at the parent zone, one entry per key and digest type.",
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"key_tag": schema.Int64Attribute{
+						Computed: true,
+					},
+					"dns_key_state": schema.StringAttribute{
+						Computed: true,
+					},
+					"algorithm": schema.StringAttribute{
+						Computed: true,
+					},
+					"algorithm_number": schema.Int64Attribute{
+						Computed: true,
+					},
+					"digest_type": schema.StringAttribute{
+						Computed: true,
+					},
+					"digest_type_number": schema.Int64Attribute{
+						Computed: true,
+					},
+					"digest": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func DnsCatalogZoneResourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the catalog zone.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"members": schema.ListAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			Description: "The names of the zones that are members of the catalog. Membership is set with the `catalog` attribute of `technitium_dns_zone`.",
+		},
+	}
+}
+
+func DnsZoneCloneResourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the new zone.",
+		},
+		"source_zone": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the zone to copy, including all of its records.",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "The type of the new zone, which is the type of the source zone.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func DnsZoneFileResourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"zone": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the zone to import the records into.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"content": schema.StringAttribute{
+			Required: true,
+			Description: "The records in RFC 1035 zone file format. Relative names are relative to the zone name. " +
+				"`$ORIGIN` and `$TTL` directives are supported, `$INCLUDE` is not.",
+		},
+		"overwrite": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Replace the existing record sets that have the same name and type as the imported records instead of adding to them. Default is false.",
+		},
+		"overwrite_soa_serial": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Use the serial of the SOA record in `content` instead of incrementing the current serial. Default is false.",
+		},
+	}
+}
+
+func DnssecPrivateKeySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"key_tag": schema.Int64Attribute{
+			Computed: true,
+		},
+		"key_type": schema.StringAttribute{
+			Computed: true,
+		},
+		"algorithm": schema.StringAttribute{
+			Computed: true,
+		},
+		"state": schema.StringAttribute{
+			Computed: true,
+		},
+		"state_changed_on": schema.StringAttribute{
+			Computed: true,
+		},
+		"state_ready_by": schema.StringAttribute{
+			Computed: true,
+		},
+		"is_retiring": schema.BoolAttribute{
+			Computed: true,
+		},
+		"rollover_days": schema.Int64Attribute{
+			Computed: true,
+		},
+	}
+}
+
+func DnsZoneDnssecKeyResourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"zone": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the signed zone.",
+		},
+		"key_type": schema.StringAttribute{
+			Required:    true,
+			Description: "The type of key. Valid values are [`KeySigningKey`, `ZoneSigningKey`].",
+		},
+		"algorithm": schema.StringAttribute{
+			Required:    true,
+			Description: "The key algorithm. Valid values are [`RSA`, `ECDSA`, `EDDSA`].",
+		},
+		"hash_algorithm": schema.StringAttribute{
+			Optional:    true,
+			Description: "The hash algorithm used with `RSA`. Valid values are [`MD5`, `SHA1`, `SHA256`, `SHA512`].",
+		},
+		"key_size": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The key size in bits when `algorithm` is `RSA`.",
+		},
+		"curve": schema.StringAttribute{
+			Optional: true,
+			Description: "The curve used with `ECDSA` or `EDDSA`. Valid values are [`P256`, `P384`] for `ECDSA` " +
+				"and [`ED25519`, `ED448`] for `EDDSA`.",
+		},
+		"rollover_days": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The number of days after which the key is automatically rolled over. Set to 0 to disable automatic rollover.",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"publish": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(true),
+			Description: "Publish the DNSKEY record once the key is generated. Publishing applies to every generated key of the zone, " +
+				"including keys of other resources. A published key cannot be unpublished, so it cannot be changed from true to false. " +
+				"Default is true.",
+		},
+		"retire": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+			Description: "Set to true to retire the key. The server refuses to retire the last active key of a type. Default is false.",
+		},
+		"key_tag": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"dnskey_algorithm": schema.StringAttribute{
+			Computed:    true,
+			Description: "The DNSKEY algorithm mnemonic, e.g. `ECDSAP256SHA256`.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"state": schema.StringAttribute{
+			Computed:    true,
+			Description: "The key state, e.g. `Generated`, `Published`, `Ready`, `Active`, `Retired` or `Revoked`.",
+		},
+		"state_changed_on": schema.StringAttribute{
+			Computed: true,
+		},
+		"state_ready_by": schema.StringAttribute{
+			Computed: true,
+		},
+		"is_retiring": schema.BoolAttribute{
+			Computed: true,
+		},
+	}
+}
+
+func DnsZoneRecordsResourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"zone": schema.StringAttribute{
+			Required:    true,
+			Description: "The name of the zone whose records are managed.",
+		},
+		"ttl": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(3600),
+			Description: "The time-to-live (TTL) in seconds of the records that do not set their own. Default is 3600.",
+		},
+		"keep_apex_ns": schema.BoolAttribute{
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+			Description: "Set to false to also remove the NS records of the zone apex that are not declared. Default is true.",
+		},
+		"records": schema.SetNestedAttribute{
+			Required:    true,
+			Description: "The complete list of records of the zone.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"domain": schema.StringAttribute{
+						Required:    true,
+						Description: "The domain name of the record, compared case-insensitively and without the trailing dot.",
+					},
+					"type": schema.StringAttribute{
+						Required:    true,
+						Description: "The type of the record. Valid values are [`A`, `AAAA`, `NS`, `CNAME`, `PTR`, `MX`, `TXT`, `SRV`, `CAA`, `SVCB`, `HTTPS`, `DS`, `SSHFP`, `TLSA`, `NAPTR`, `URI`, `ANAME`, `DNAME`].",
+					},
+					"ttl": schema.Int64Attribute{
+						Optional:    true,
+						Description: "The time-to-live (TTL) of the record in seconds. When not set, the `ttl` of the resource is used.",
+					},
+					"value": schema.StringAttribute{
+						Required:    true,
+						Description: "The record data in its zone file form, for example `192.0.2.1` for `A` or `10 mail.example.com` for `MX`.",
+					},
+				},
+			},
+		},
+		"unmanaged_records": schema.SetNestedAttribute{
+			Computed: true,
+			Description: "The records of the zone that are not declared in `records`. They are removed on the next apply, " +
+				"so the plan lists them as the records to delete. Before the first apply, a warning of the plan lists them instead.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"domain": schema.StringAttribute{
+						Computed: true,
+					},
+					"type": schema.StringAttribute{
+						Computed: true,
+					},
+					"ttl": schema.Int64Attribute{
+						Computed: true,
+					},
+					"value": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func DnsRecordSetResourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"zone": schema.StringAttribute{
+			Optional:    true,
+			Description: "The zone of the record set. When not set, the closest zone of the domain is used.",
+		},
+		"domain": schema.StringAttribute{
+			Required:    true,
+			Description: "The domain name of the records.",
+		},
+		"type": schema.StringAttribute{
+			Required:    true,
+			Description: "The type of the records. Valid values are [`A`, `AAAA`, `NS`, `CNAME`, `PTR`, `MX`, `TXT`, `SRV`, `CAA`, `SVCB`, `HTTPS`, `DS`, `SSHFP`, `TLSA`, `NAPTR`, `URI`, `ANAME`, `DNAME`].",
+		},
+		"ttl": schema.Int64Attribute{
+			Optional:    true,
+			Computed:    true,
+			Description: "The time-to-live (TTL) of every record of the set in seconds.",
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"values": schema.SetAttribute{
+			Required:    true,
+			ElementType: types.StringType,
+			Description: "The record data of each record in its zone file form, for example `192.0.2.1` for `A`, `10 mail.example.com` for `MX`, " +
+				"`10 60 5060 sip.example.com` for `SRV`, `0 issue \"letsencrypt.org\"` for `CAA`, `1 . alpn=h2,h3` for `HTTPS`, `10 1 \"https://www.example.com/\"` for `URI` or the text of a `TXT` record. Domain names are compared case-insensitively and without the trailing dot.",
+		},
 	}
 }
 
 func DnsZoneRecordResourceSchema() map[string]schema.Attribute {
 	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed: true,
+			Description: "Identifies the record by zone, domain, type and record value in the form `zone/domain/type/value`. " +
+				"The value is the record data in its zone file form, such as `10 mail.example.com` for an MX record. " +
+				"When no record of the domain and type has the value anymore, the record is removed from the state.",
+		},
 		"domain": schema.StringAttribute{
 			Required: true,
 		},
@@ -179,6 +681,14 @@ func DnsZoneRecordResourceSchema() map[string]schema.Attribute {
 		"cname": schema.StringAttribute{
 			Optional: true,
 		},
+		"aname": schema.StringAttribute{
+			Optional:    true,
+			Description: "The domain name the ANAME record resolves its addresses from, allowed at the zone apex unlike `cname`. This option is required for adding ANAME record.",
+		},
+		"dname": schema.StringAttribute{
+			Optional:    true,
+			Description: "The domain name the subtree of the DNAME record is redirected to. This option is required for adding DNAME record.",
+		},
 		"ptr_name": schema.StringAttribute{
 			Optional: true,
 		},
@@ -230,15 +740,139 @@ func DnsZoneRecordResourceSchema() map[string]schema.Attribute {
 		},
 		"app_name": schema.StringAttribute{
 			Optional:    true,
-			Description: "DNS app name, required for `APP` records",
+			Description: "DNS app name, required for `APP` records. The app must be installed on the server.",
 		},
 		"class_path": schema.StringAttribute{
 			Optional:    true,
-			Description: "DNS app class path",
+			Description: "DNS app class path, one of the APP record handlers of the app such as `GeoCountry.Address`.",
 		},
 		"record_data": schema.StringAttribute{
+			Optional:   true,
+			CustomType: jsonStringType{},
+			Description: "DNS app record data as a JSON document, for example from `jsonencode()`. " +
+				"Documents that only differ in formatting or in the order of object members are equal.",
+		},
+		"priority": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The priority of the target host. This option is required for adding SRV record.",
+		},
+		"weight": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The relative weight of targets with the same priority. This option is required for adding SRV record.",
+		},
+		"port": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The port of the service on the target host. This option is required for adding SRV record.",
+		},
+		"target": schema.StringAttribute{
+			Optional:    true,
+			Description: "The domain name of the target host. This option is required for adding SRV record.",
+		},
+		"flags": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The flags of the CAA record, `128` marks the property as critical. This option is required for adding CAA record.",
+		},
+		"tag": schema.StringAttribute{
+			Optional:    true,
+			Description: "The property tag of the CAA record. Valid values are [`issue`, `issuewild`, `iodef`]. This option is required for adding CAA record.",
+		},
+		"value": schema.StringAttribute{
+			Optional:    true,
+			Description: "The property value of the CAA record, such as the domain name of a CA or an `iodef` URL. This option is required for adding CAA record.",
+		},
+		"svc_priority": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The priority of the SVCB or HTTPS record, `0` for alias mode. This option is required for adding SVCB and HTTPS records.",
+		},
+		"svc_target_name": schema.StringAttribute{
+			Optional:    true,
+			Description: "The target name of the SVCB or HTTPS record, `.` for the owner name of the record. This option is required for adding SVCB and HTTPS records.",
+		},
+		"svc_params": schema.MapAttribute{
+			Optional:    true,
+			ElementType: types.StringType,
+			Description: "The service parameters of the SVCB or HTTPS record by key, such as `alpn`, `port`, `ipv4hint`, `ipv6hint` and `ech`. " +
+				"Lists of values are separated by commas, for example `h2,h3`.",
+		},
+		"key_tag": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The key tag of the DNSKEY referenced by the DS record. This option is required for adding DS record.",
+		},
+		"algorithm": schema.StringAttribute{
+			Optional:    true,
+			Description: "The DNSSEC algorithm of the DS record, such as `RSASHA256` or `ECDSAP256SHA256`, or its number. This option is required for adding DS record.",
+		},
+		"digest_type": schema.StringAttribute{
+			Optional:    true,
+			Description: "The digest type of the DS record. Valid values are [`SHA1`, `SHA256`, `SHA384`] or their numbers. This option is required for adding DS record.",
+		},
+		"digest": schema.StringAttribute{
+			Optional:    true,
+			Description: "The digest of the DS record in hexadecimal, compared case-insensitively. This option is required for adding DS record.",
+		},
+		"sshfp_algorithm": schema.StringAttribute{
+			Optional:    true,
+			Description: "The public key algorithm of the SSHFP record. Valid values are [`RSA`, `DSA`, `ECDSA`, `Ed25519`, `Ed448`]. This option is required for adding SSHFP record.",
+		},
+		"sshfp_fingerprint_type": schema.StringAttribute{
+			Optional:    true,
+			Description: "The fingerprint type of the SSHFP record. Valid values are [`SHA1`, `SHA256`]. This option is required for adding SSHFP record.",
+		},
+		"sshfp_fingerprint": schema.StringAttribute{
+			Optional:    true,
+			Description: "The fingerprint of the SSHFP record in hexadecimal, compared case-insensitively. This option is required for adding SSHFP record.",
+		},
+		"tlsa_certificate_usage": schema.StringAttribute{
+			Optional:    true,
+			Description: "The certificate usage of the TLSA record. Valid values are [`PKIX-TA`, `PKIX-EE`, `DANE-TA`, `DANE-EE`]. This option is required for adding TLSA record.",
+		},
+		"tlsa_selector": schema.StringAttribute{
+			Optional:    true,
+			Description: "The selector of the TLSA record. Valid values are [`Cert`, `SPKI`]. This option is required for adding TLSA record.",
+		},
+		"tlsa_matching_type": schema.StringAttribute{
+			Optional:    true,
+			Description: "The matching type of the TLSA record. Valid values are [`Full`, `SHA2-256`, `SHA2-512`]. This option is required for adding TLSA record.",
+		},
+		"tlsa_certificate_association_data": schema.StringAttribute{
+			Optional:    true,
+			Description: "The certificate association data of the TLSA record in hexadecimal, compared case-insensitively. This option is required for adding TLSA record.",
+		},
+		"naptr_order": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The order in which the NAPTR records must be processed, lowest first. This option is required for adding NAPTR record.",
+		},
+		"naptr_preference": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The preference of the NAPTR record among the records with the same order. This option is required for adding NAPTR record.",
+		},
+		"naptr_flags": schema.StringAttribute{
+			Optional:    true,
+			Description: "The flags of the NAPTR record, such as `U`, `S`, `A` or `P`, compared case-insensitively.",
+		},
+		"naptr_services": schema.StringAttribute{
+			Optional:    true,
+			Description: "The services of the NAPTR record, such as `E2U+sip`.",
+		},
+		"naptr_regexp": schema.StringAttribute{
+			Optional:    true,
+			Description: "The substitution expression of the NAPTR record applied to the original string, such as `!^.*$!sip:info@example.com!`.",
+		},
+		"naptr_replacement": schema.StringAttribute{
+			Optional:    true,
+			Description: "The replacement domain name of the NAPTR record, used when no `naptr_regexp` is set.",
+		},
+		"uri_priority": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The priority of the URI record, lowest first. This option is required for adding URI record.",
+		},
+		"uri_weight": schema.Int64Attribute{
+			Optional:    true,
+			Description: "The weight of the URI record among the records with the same priority. This option is required for adding URI record.",
+		},
+		"uri": schema.StringAttribute{
 			Optional:    true,
-			Description: "DNS app record data",
+			Description: "The target URI of the URI record. This option is required for adding URI record.",
 		},
 	}
 }
@@ -0,0 +1,8 @@
+resource "technitium_dns_catalog_zone" "test" {
+  name = "catalog.example"
+}
+
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
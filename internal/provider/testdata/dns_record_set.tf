@@ -0,0 +1,19 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_record_set" "www" {
+  zone   = technitium_dns_zone.test.name
+  domain = "www.example.com"
+  type   = "A"
+  ttl    = 300
+  values = ["192.0.2.1", "192.0.2.2"]
+}
+
+resource "technitium_dns_record_set" "mx" {
+  zone   = technitium_dns_zone.test.name
+  domain = "example.com"
+  type   = "MX"
+  values = ["10 mail1.example.com", "20 mail2.example.com."]
+}
@@ -0,0 +1,19 @@
+resource "technitium_dns_zone" "source" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_record" "www" {
+  zone       = technitium_dns_zone.source.name
+  domain     = "www.example.com"
+  type       = "A"
+  ttl        = 3600
+  ip_address = "192.0.2.1"
+}
+
+resource "technitium_dns_zone_clone" "test" {
+  name        = "staging.example.com"
+  source_zone = technitium_dns_zone.source.name
+
+  depends_on = [technitium_dns_zone_record.www]
+}
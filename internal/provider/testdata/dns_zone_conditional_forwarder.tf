@@ -0,0 +1,8 @@
+resource "technitium_dns_zone" "test" {
+  name                 = "forwarded.example.com"
+  type                 = "Forwarder"
+  initialize_forwarder = true
+  forwarder            = "192.0.2.53"
+  protocol             = "Tcp"
+  dnssec_validation    = true
+}
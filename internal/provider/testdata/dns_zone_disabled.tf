@@ -0,0 +1,5 @@
+resource "technitium_dns_zone" "test" {
+  name     = "example.com"
+  type     = "Primary"
+  disabled = true
+}
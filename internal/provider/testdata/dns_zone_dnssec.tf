@@ -0,0 +1,10 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_dnssec" "test" {
+  zone      = technitium_dns_zone.test.name
+  algorithm = "ECDSA"
+  curve     = "P256"
+}
@@ -0,0 +1,20 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_dnssec" "test" {
+  zone              = technitium_dns_zone.test.name
+  algorithm         = "ECDSA"
+  curve             = "P256"
+  zsk_rollover_days = 90
+}
+
+resource "technitium_dns_zone_dnssec_key" "test" {
+  zone          = technitium_dns_zone_dnssec.test.zone
+  key_type      = "ZoneSigningKey"
+  algorithm     = "ECDSA"
+  curve         = "P384"
+  publish       = true
+  rollover_days = 60
+}
@@ -0,0 +1,17 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_file" "test" {
+  zone      = technitium_dns_zone.test.name
+  overwrite = true
+  content   = <<-EOT
+    $TTL 3600
+    www   IN A     192.0.2.1
+          IN AAAA  2001:db8::1
+    @     IN MX    10 mail
+    mail  IN A     192.0.2.25
+    @     IN TXT   "v=spf1 mx -all"
+  EOT
+}
@@ -0,0 +1,4 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Forwarder"
+}
@@ -0,0 +1,12 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_options" "test" {
+  zone                      = technitium_dns_zone.test.name
+  zone_transfer             = "UseSpecifiedNetworkACL"
+  zone_transfer_network_acl = ["192.168.10.0/24"]
+  notify                    = "SpecifiedNameServers"
+  notify_name_servers       = ["192.168.10.2"]
+}
@@ -0,0 +1,11 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_options" "test" {
+  zone                      = technitium_dns_zone.test.name
+  zone_transfer             = "UseSpecifiedNetworkACL"
+  zone_transfer_network_acl = ["192.168.10.0/24", "!192.168.10.1"]
+  notify                    = "ZoneNameServers"
+}
@@ -0,0 +1,20 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_record" "aname" {
+  zone   = technitium_dns_zone.test.name
+  domain = "example.com"
+  type   = "ANAME"
+  aname  = "lb2.example.net"
+  ttl    = 3600
+}
+
+resource "technitium_dns_zone_record" "dname" {
+  zone   = technitium_dns_zone.test.name
+  domain = "legacy.example.com"
+  type   = "DNAME"
+  dname  = "example.org"
+  ttl    = 3600
+}
@@ -0,0 +1,15 @@
+resource "technitium_dns_zone" "example" {
+  name = "example.com"
+  type = "Primary"
+}
+
+
+resource "technitium_dns_zone_record" "app" {
+  zone        = technitium_dns_zone.example.name
+  domain      = "test.${technitium_dns_zone.example.name}"
+  type        = "APP"
+  app_name    = "NO DATA"
+  class_path  = "NoData.App"
+  record_data = jsonencode({ blockedTypes = ["HTTPS"] })
+  depends_on  = [technitium_dns_zone.example]
+}
@@ -0,0 +1,24 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_record" "issue" {
+  zone   = technitium_dns_zone.test.name
+  domain = "example.com"
+  type   = "CAA"
+  flags  = 0
+  tag    = "issue"
+  value  = "letsencrypt.org"
+  ttl    = 3600
+}
+
+resource "technitium_dns_zone_record" "iodef" {
+  zone   = technitium_dns_zone.test.name
+  domain = "example.com"
+  type   = "CAA"
+  flags  = 0
+  tag    = "iodef"
+  value  = "mailto:security@example.com"
+  ttl    = 3600
+}
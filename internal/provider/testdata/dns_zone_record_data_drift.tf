@@ -0,0 +1,29 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_record" "cname" {
+  zone   = technitium_dns_zone.test.name
+  domain = "alias.example.com"
+  type   = "CNAME"
+  cname  = "www.example.com"
+  ttl    = 3600
+}
+
+resource "technitium_dns_zone_record" "txt" {
+  zone   = technitium_dns_zone.test.name
+  domain = "example.com"
+  type   = "TXT"
+  text   = "v=spf1 -all"
+  ttl    = 3600
+}
+
+resource "technitium_dns_zone_record" "mx" {
+  zone       = technitium_dns_zone.test.name
+  domain     = "example.com"
+  type       = "MX"
+  exchange   = "mail.example.com"
+  preference = 10
+  ttl        = 3600
+}
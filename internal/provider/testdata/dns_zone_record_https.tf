@@ -0,0 +1,17 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_record" "https" {
+  zone            = technitium_dns_zone.test.name
+  domain          = "example.com"
+  type            = "HTTPS"
+  svc_priority    = 1
+  svc_target_name = "."
+  svc_params = {
+    alpn = "h2,h3"
+    port = "443"
+  }
+  ttl = 3600
+}
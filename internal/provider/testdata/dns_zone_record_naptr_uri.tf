@@ -0,0 +1,27 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_record" "naptr" {
+  zone              = technitium_dns_zone.test.name
+  domain            = "voip.example.com"
+  type              = "NAPTR"
+  naptr_order       = 100
+  naptr_preference  = 10
+  naptr_flags       = "U"
+  naptr_services    = "E2U+sip"
+  naptr_regexp      = "!^.*$!sip:info@example.com!"
+  naptr_replacement = "."
+  ttl               = 3600
+}
+
+resource "technitium_dns_zone_record" "uri" {
+  zone         = technitium_dns_zone.test.name
+  domain       = "_http._tcp.example.com"
+  type         = "URI"
+  uri_priority = 10
+  uri_weight   = 1
+  uri          = "https://www.example.com/"
+  ttl          = 3600
+}
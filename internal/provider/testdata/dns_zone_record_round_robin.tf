@@ -0,0 +1,20 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_record" "first" {
+  zone       = technitium_dns_zone.test.name
+  domain     = "www.example.com"
+  type       = "A"
+  ip_address = "192.0.2.1"
+  ttl        = 300
+}
+
+resource "technitium_dns_zone_record" "second" {
+  zone       = technitium_dns_zone.test.name
+  domain     = "www.example.com"
+  type       = "A"
+  ip_address = "192.0.2.2"
+  ttl        = 600
+}
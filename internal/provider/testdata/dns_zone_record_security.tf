@@ -0,0 +1,36 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_record" "tlsa" {
+  zone                              = technitium_dns_zone.test.name
+  domain                            = "_25._tcp.mail.example.com"
+  type                              = "TLSA"
+  tlsa_certificate_usage            = "DANE-EE"
+  tlsa_selector                     = "SPKI"
+  tlsa_matching_type                = "SHA2-256"
+  tlsa_certificate_association_data = "8CB0FC6C527506A053F4F14C8464BEBBD6DEDE2738D11468DD953D7D6A3021F1"
+  ttl                               = 3600
+}
+
+resource "technitium_dns_zone_record" "sshfp" {
+  zone                   = technitium_dns_zone.test.name
+  domain                 = "host.example.com"
+  type                   = "SSHFP"
+  sshfp_algorithm        = "Ed25519"
+  sshfp_fingerprint_type = "SHA256"
+  sshfp_fingerprint      = "A1B2C3D4E5F60718293A4B5C6D7E8F90A1B2C3D4E5F60718293A4B5C6D7E8F90"
+  ttl                    = 3600
+}
+
+resource "technitium_dns_zone_record" "ds" {
+  zone        = technitium_dns_zone.test.name
+  domain      = "child.example.com"
+  type        = "DS"
+  key_tag     = 12345
+  algorithm   = "ECDSAP256SHA256"
+  digest_type = "SHA256"
+  digest      = "3E0A9F0F6F5A2D3C4B1E8D7C6B5A49382716F5E4D3C2B1A09F8E7D6C5B4A3928"
+  ttl         = 3600
+}
@@ -0,0 +1,15 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_record" "srv" {
+  zone     = technitium_dns_zone.test.name
+  domain   = "_sip._tcp.example.com"
+  type     = "SRV"
+  priority = 0
+  weight   = 5
+  port     = 5061
+  target   = "sip2.example.com"
+  ttl      = 3600
+}
@@ -0,0 +1,15 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_records" "test" {
+  zone = technitium_dns_zone.test.name
+
+  records = [
+    { domain = "example.com", type = "A", value = "192.0.2.1" },
+    { domain = "example.com", type = "MX", value = "10 Mail.example.com." },
+    { domain = "mail.example.com", type = "A", value = "192.0.2.25" },
+    { domain = "www.example.com", type = "CNAME", value = "example.com", ttl = 300 },
+  ]
+}
@@ -0,0 +1,16 @@
+resource "technitium_dns_zone" "test" {
+  name = "example.com"
+  type = "Primary"
+}
+
+resource "technitium_dns_zone_records" "test" {
+  zone = technitium_dns_zone.test.name
+  ttl  = 600
+
+  records = [
+    { domain = "example.com", type = "A", value = "192.0.2.1" },
+    { domain = "example.com", type = "MX", value = "10 mail.example.com" },
+    { domain = "example.com", type = "TXT", value = "v=spf1 mx -all" },
+    { domain = "www.example.com", type = "CNAME", value = "example.com", ttl = 300 },
+  ]
+}
@@ -0,0 +1,5 @@
+resource "technitium_dns_zone" "test" {
+  name                          = "example.com"
+  type                          = "Stub"
+  primary_name_server_addresses = ["192.0.2.53"]
+}
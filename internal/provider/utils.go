@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"terraform-provider-technitium/internal/technitium"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -38,6 +40,50 @@ func convertTfListToStringList(items []types.String) []string {
 	return attr
 }
 
+// convertListValueToStringList returns nil for null and unknown lists so that they
+// are left out of API requests, and a non-nil slice otherwise.
+func convertListValueToStringList(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+
+	items := make([]string, 0, len(list.Elements()))
+	diags := list.ElementsAs(ctx, &items, false)
+
+	return items, diags
+}
+
+// convertStringListToListValue always returns a known list, empty when there are no items.
+func convertStringListToListValue(ctx context.Context, items []string) (types.List, diag.Diagnostics) {
+	return types.ListValueFrom(ctx, types.StringType, append([]string{}, items...))
+}
+
+// convertMapValueToStringMap returns nil for null and unknown maps, and the string elements otherwise.
+func convertMapValueToStringMap(m types.Map) map[string]string {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+
+	items := make(map[string]string, len(m.Elements()))
+	for key, value := range m.Elements() {
+		if item, ok := value.(types.String); ok {
+			items[key] = item.ValueString()
+		}
+	}
+
+	return items
+}
+
+// convertStringMapToMapValue always returns a known map, empty when there are no items.
+func convertStringMapToMapValue(items map[string]string) types.Map {
+	elements := make(map[string]attr.Value, len(items))
+	for key, item := range items {
+		elements[key] = types.StringValue(item)
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
+
 func ConfigureResourceClient(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *technitium.Client {
 	if req.ProviderData == nil {
 		return nil
@@ -78,7 +124,7 @@ func setStringIfNotEmpty(target *types.String, value string) {
 	}
 }
 
-func updateZoneRecord(ctx context.Context) error {
+func testClient(ctx context.Context) (*technitium.Client, error) {
 	host := "http://localhost:5380"
 	username := "admin"
 	password := "password"
@@ -86,13 +132,22 @@ func updateZoneRecord(ctx context.Context) error {
 	token, err := technitium.GetToken(host, username, password)
 
 	if err != nil {
-		return fmt.Errorf("error getting token: %v", err)
+		return nil, fmt.Errorf("error getting token: %v", err)
 	}
 
 	client, err := technitium.NewClient(host, token, ctx)
 
 	if err != nil {
-		return fmt.Errorf("error creating client: %v", err)
+		return nil, fmt.Errorf("error creating client: %v", err)
+	}
+
+	return client, nil
+}
+
+func updateZoneRecord(ctx context.Context) error {
+	client, err := testClient(ctx)
+	if err != nil {
+		return err
 	}
 
 	update := technitium.DnsZoneRecordUpdate{
@@ -113,3 +168,45 @@ func updateZoneRecord(ctx context.Context) error {
 	return nil
 
 }
+
+func deleteZoneRecord(ctx context.Context, record technitium.DnsZoneRecordCreate) error {
+	client, err := testClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = client.DeleteDnsZoneRecord(record, ctx)
+	if err != nil {
+		return fmt.Errorf("error deleting DNS zone record: %v", err)
+	}
+
+	return nil
+}
+
+func changeZoneRecord(ctx context.Context, update technitium.DnsZoneRecordUpdate) error {
+	client, err := testClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = client.UpdateDnsZoneRecord(update, ctx)
+	if err != nil {
+		return fmt.Errorf("error updating DNS zone record: %v", err)
+	}
+
+	return nil
+}
+
+func createZoneRecord(ctx context.Context, record technitium.DnsZoneRecordCreate) error {
+	client, err := testClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = client.CreateDnsZoneRecord(record, ctx)
+	if err != nil {
+		return fmt.Errorf("error creating DNS zone record: %v", err)
+	}
+
+	return nil
+}
@@ -146,3 +146,15 @@ func (c *Client) GetRequest(path string) (*http.Request, error) {
 	return req, nil
 
 }
+
+// PostRequest builds a POST request that sends the given body with the given content type.
+func (c *Client) PostRequest(path string, contentType string, body string) (*http.Request, error) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s%s", c.HostURL, path), strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	return req, nil
+}
@@ -0,0 +1,44 @@
+package technitium
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ListDnsApps returns the DNS apps installed on the server with their handlers.
+func (c *Client) ListDnsApps(ctx context.Context) ([]DnsApp, error) {
+
+	req, err := c.GetRequest("/api/apps/list")
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := DnsAppsResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Status != "ok" {
+		return nil, fmt.Errorf("failed to list DNS apps: %s", response.ErrorMessage)
+	}
+
+	return response.Response.Apps, nil
+}
+
+// AppRecordClassPaths returns the class paths of the handlers of the app that can be used in APP records.
+func (a DnsApp) AppRecordClassPaths() []string {
+	var classPaths []string
+	for _, handler := range a.DnsApps {
+		if handler.IsAppRecordRequestHandler {
+			classPaths = append(classPaths, handler.ClassPath)
+		}
+	}
+	return classPaths
+}
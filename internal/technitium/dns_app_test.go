@@ -0,0 +1,51 @@
+package technitium
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"terraform-provider-technitium/internal/test"
+	"testing"
+)
+
+func TestClient_ListDnsApps(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful list", func(t *testing.T) {
+		scenario := test.GetMockScenarioFromFile(t, "../test/mocks/dns_apps_response.json", http.StatusOK)
+		client, cleanup := GetMockClient(scenario)
+		defer cleanup()
+
+		apps, err := client.ListDnsApps(ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(apps) != 2 {
+			t.Fatalf("Expected 2 apps, got %d", len(apps))
+		}
+
+		classPaths := apps[0].AppRecordClassPaths()
+		if apps[0].Name != "Geo Country" || strings.Join(classPaths, ",") != "GeoCountry.Address,GeoCountry.CNAME" {
+			t.Errorf("Unexpected app %s with APP record class paths %v", apps[0].Name, classPaths)
+		}
+
+		if len(apps[1].AppRecordClassPaths()) != 0 {
+			t.Errorf("Expected no APP record class paths for %s, got %v", apps[1].Name, apps[1].AppRecordClassPaths())
+		}
+	})
+
+	t.Run("error response", func(t *testing.T) {
+		scenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"error","errorMessage":"Access was denied."}`,
+		}
+		client, cleanup := GetMockClient(scenario)
+		defer cleanup()
+
+		_, err := client.ListDnsApps(ctx)
+		if err == nil || !strings.Contains(err.Error(), "Access was denied.") {
+			t.Errorf("Expected access denied error, got %v", err)
+		}
+	})
+}
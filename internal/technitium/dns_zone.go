@@ -5,31 +5,79 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
-func (c *Client) GetDnsZones(ctx context.Context) ([]DnsZoneList, error) {
-	url := fmt.Sprintf("%s/api/zones/list", c.HostURL)
+// DefaultZonesPerPage is the page size used by GetDnsZones when none is given.
+const DefaultZonesPerPage = 100
 
-	req, err := http.NewRequest("GET", url, nil)
-	if err != nil {
-		return nil, err
+// GetDnsZones returns all zones, requesting one page of zonesPerPage zones at a time.
+func (c *Client) GetDnsZones(zonesPerPage int, ctx context.Context) ([]DnsZoneList, error) {
+
+	if zonesPerPage <= 0 {
+		zonesPerPage = DefaultZonesPerPage
 	}
 
-	body, err := c.doRequest(req, ctx)
-	if err != nil {
-		return nil, err
+	zones := make([]DnsZoneList, 0)
+	for pageNumber := 1; ; pageNumber++ {
+		req, err := c.GetRequest("/api/zones/list")
+		if err != nil {
+			return nil, err
+		}
+
+		params := req.URL.Query()
+		params.Add("pageNumber", strconv.Itoa(pageNumber))
+		params.Add("zonesPerPage", strconv.Itoa(zonesPerPage))
+		req.URL.RawQuery = params.Encode()
+
+		body, err := c.doRequest(req, ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		response := DnsZonesResponse{}
+		err = json.Unmarshal(body, &response)
+		if err != nil {
+			return nil, err
+		}
+
+		if response.Status != "" && response.Status != "ok" {
+			return nil, fmt.Errorf("failed to list zones: %s", response.ErrorMessage)
+		}
+
+		zones = append(zones, response.Response.Zones...)
+
+		// Servers without pagination support return every zone and no page count
+		if pageNumber >= response.Response.TotalPages || len(response.Response.Zones) == 0 {
+			break
+		}
 	}
 
-	//tflog.Debug(ctx, string(body))
+	return zones, nil
+}
+
+// GetCatalogZoneMembers returns the names of the zones that are members of the catalog zone.
+func (c *Client) GetCatalogZoneMembers(catalog string, ctx context.Context) ([]string, error) {
 
-	response := DnsZonesResponse{}
-	err = json.Unmarshal(body, &response)
+	zones, err := c.GetDnsZones(0, ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.Response.Zones, nil
+	members := make([]string, 0)
+	for _, zone := range zones {
+		if strings.EqualFold(zone.Catalog, catalog) {
+			members = append(members, zone.Name)
+		}
+	}
+
+	return members, nil
+}
+
+// IsZoneNotFound reports whether err is the error the server returns for a zone that does not exist.
+func IsZoneNotFound(err error) bool {
+	return err != nil && strings.Contains(strings.ToLower(err.Error()), "no such zone")
 }
 
 func (c *Client) GetDnsZone(name string, ctx context.Context) (DnsZone, error) {
@@ -51,9 +99,83 @@ func (c *Client) GetDnsZone(name string, ctx context.Context) (DnsZone, error) {
 		return DnsZone{}, err
 	}
 
+	if response.Status != "ok" {
+		return DnsZone{}, fmt.Errorf("failed to get zone: %s", response.ErrorMessage)
+	}
+
 	return response.Response, nil
 }
 
+func (c *Client) SetDnsZoneOptions(o DnsZoneOptions, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/options/set")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", o.Zone)
+
+	if o.Catalog != nil {
+		params.Add("catalog", *o.Catalog)
+	}
+	if o.PrimaryNameServerAddresses != nil {
+		params.Add("primaryNameServerAddresses", strings.Join(o.PrimaryNameServerAddresses, ","))
+	}
+	if o.PrimaryZoneTransferProtocol != nil {
+		params.Add("primaryZoneTransferProtocol", *o.PrimaryZoneTransferProtocol)
+	}
+	if o.PrimaryZoneTransferTsigKeyName != nil {
+		params.Add("primaryZoneTransferTsigKeyName", *o.PrimaryZoneTransferTsigKeyName)
+	}
+	if o.QueryAccess != "" {
+		params.Add("queryAccess", o.QueryAccess)
+	}
+	if o.QueryAccessNetworkAcl != nil {
+		params.Add("queryAccessNetworkACL", strings.Join(o.QueryAccessNetworkAcl, ","))
+	}
+	if o.ZoneTransfer != "" {
+		params.Add("zoneTransfer", o.ZoneTransfer)
+	}
+	if o.ZoneTransferNetworkAcl != nil {
+		params.Add("zoneTransferNetworkACL", strings.Join(o.ZoneTransferNetworkAcl, ","))
+	}
+	if o.ZoneTransferTsigKeyNames != nil {
+		params.Add("zoneTransferTsigKeyNames", strings.Join(o.ZoneTransferTsigKeyNames, ","))
+	}
+	if o.Notify != "" {
+		params.Add("notify", o.Notify)
+	}
+	if o.NotifyNameServers != nil {
+		params.Add("notifyNameServers", strings.Join(o.NotifyNameServers, ","))
+	}
+	if o.Update != "" {
+		params.Add("update", o.Update)
+	}
+	if o.UpdateNetworkAcl != nil {
+		params.Add("updateNetworkACL", strings.Join(o.UpdateNetworkAcl, ","))
+	}
+
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to set zone options: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
 func (c *Client) CreateDnsZone(z DnsZoneCreate, ctx context.Context) (DnsZone, error) {
 
 	req, err := c.GetRequest("/api/zones/create")
@@ -122,3 +244,273 @@ func (c *Client) DeleteDnsZone(name string, ctx context.Context) error {
 
 	return nil
 }
+
+func (c *Client) EnableDnsZone(name string, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/enable")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", name)
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to enable zone: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
+func (c *Client) DisableDnsZone(name string, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/disable")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", name)
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to disable zone: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
+// dnsZoneConversions lists the zone types each zone type can be converted to.
+var dnsZoneConversions = map[string][]string{
+	"Primary":            {"Forwarder"},
+	"Secondary":          {"Primary", "Forwarder"},
+	"Forwarder":          {"Primary"},
+	"SecondaryForwarder": {"Forwarder"},
+}
+
+// CanConvertDnsZone reports whether the server can convert a zone of type from to type to in place.
+func CanConvertDnsZone(from string, to string) bool {
+	for _, t := range dnsZoneConversions[from] {
+		if t == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ConvertDnsZone changes the type of a zone, keeping its records.
+func (c *Client) ConvertDnsZone(zone string, zoneType string, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/convert")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	params.Add("type", zoneType)
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to convert zone: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
+// ResyncDnsZone starts a new zone transfer from the primary name servers of a secondary or stub zone.
+func (c *Client) ResyncDnsZone(zone string, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/resync")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to resync zone: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
+// GetDnsZoneStatus returns the list entry of a zone, which includes its transfer status.
+func (c *Client) GetDnsZoneStatus(name string, ctx context.Context) (DnsZoneList, error) {
+
+	zones, err := c.GetDnsZones(0, ctx)
+	if err != nil {
+		return DnsZoneList{}, err
+	}
+
+	for _, zone := range zones {
+		if strings.EqualFold(strings.TrimSuffix(zone.Name, "."), strings.TrimSuffix(name, ".")) {
+			return zone, nil
+		}
+	}
+
+	return DnsZoneList{}, fmt.Errorf("no such zone was found: %s", name)
+}
+
+// GetClosestDnsZone returns the name of the zone that the server adds records of the domain to, which is the zone
+// with the longest name that is the domain or one of its parents.
+func (c *Client) GetClosestDnsZone(domain string, ctx context.Context) (string, error) {
+
+	zones, err := c.GetDnsZones(0, ctx)
+	if err != nil {
+		return "", err
+	}
+
+	name := strings.ToLower(strings.TrimSuffix(domain, "."))
+	closest := ""
+	for _, zone := range zones {
+		zoneName := strings.ToLower(strings.TrimSuffix(zone.Name, "."))
+		if (name == zoneName || strings.HasSuffix(name, "."+zoneName)) && len(zoneName) > len(closest) {
+			closest = zoneName
+		}
+	}
+
+	if closest == "" {
+		return "", fmt.Errorf("no such zone was found for domain: %s", domain)
+	}
+
+	return closest, nil
+}
+
+// CloneDnsZone creates the zone as a copy of the source zone including all of its records.
+func (c *Client) CloneDnsZone(zone string, sourceZone string, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/clone")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	params.Add("sourceZone", sourceZone)
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to clone zone: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
+// ImportDnsZone adds the records of an RFC 1035 zone file to the zone.
+func (c *Client) ImportDnsZone(zone string, content string, overwrite bool, overwriteSoaSerial bool, ctx context.Context) error {
+
+	req, err := c.PostRequest("/api/zones/import", "text/plain", content)
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	params.Add("overwrite", fmt.Sprintf("%t", overwrite))
+	params.Add("overwriteSoaSerial", fmt.Sprintf("%t", overwriteSoaSerial))
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to import zone: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
+// ExportDnsZone returns the zone in RFC 1035 zone file format.
+func (c *Client) ExportDnsZone(zone string, ctx context.Context) (string, error) {
+
+	req, err := c.GetRequest("/api/zones/export")
+	if err != nil {
+		return "", err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// The zone file is returned as plain text, errors are reported as JSON
+	if strings.HasPrefix(strings.TrimSpace(string(body)), "{") {
+		response := BaseResponse{}
+		if json.Unmarshal(body, &response) == nil && response.Status != "" && response.Status != "ok" {
+			return "", fmt.Errorf("failed to export zone: %s", response.ErrorMessage)
+		}
+	}
+
+	return string(body), nil
+}
@@ -0,0 +1,449 @@
+package technitium
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// DnssecAlgorithmNumbers maps the DNSKEY algorithm mnemonics returned by the API
+// to their IANA numbers, as used in DS records published at the parent zone.
+var DnssecAlgorithmNumbers = map[string]int64{
+	"RSAMD5":             1,
+	"DSA":                3,
+	"RSASHA1":            5,
+	"DSA-NSEC3-SHA1":     6,
+	"RSASHA1-NSEC3-SHA1": 7,
+	"RSASHA256":          8,
+	"RSASHA512":          10,
+	"ECC-GOST":           12,
+	"ECDSAP256SHA256":    13,
+	"ECDSAP384SHA384":    14,
+	"ED25519":            15,
+	"ED448":              16,
+}
+
+// DsDigestTypeNumbers maps the DS digest type mnemonics returned by the API to their IANA numbers.
+var DsDigestTypeNumbers = map[string]int64{
+	"SHA1":   1,
+	"SHA256": 2,
+	"GOST":   3,
+	"SHA384": 4,
+}
+
+func (c *Client) SignDnsZone(s DnsZoneDnssecSign, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/dnssec/sign")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", s.Zone)
+	params.Add("algorithm", s.Algorithm)
+
+	if s.HashAlgorithm != "" {
+		params.Add("hashAlgorithm", s.HashAlgorithm)
+	}
+	if s.KskKeySize != 0 {
+		params.Add("kskKeySize", fmt.Sprintf("%d", s.KskKeySize))
+	}
+	if s.ZskKeySize != 0 {
+		params.Add("zskKeySize", fmt.Sprintf("%d", s.ZskKeySize))
+	}
+	if s.Curve != "" {
+		params.Add("curve", s.Curve)
+	}
+	if s.DnsKeyTTL != 0 {
+		params.Add("dnsKeyTtl", fmt.Sprintf("%d", s.DnsKeyTTL))
+	}
+	if s.ZskRolloverDays != 0 {
+		params.Add("zskRolloverDays", fmt.Sprintf("%d", s.ZskRolloverDays))
+	}
+	if s.NxProof != "" {
+		params.Add("nxProof", s.NxProof)
+	}
+	if s.NxProof == "NSEC3" {
+		params.Add("iterations", fmt.Sprintf("%d", s.Iterations))
+		params.Add("saltLength", fmt.Sprintf("%d", s.SaltLength))
+	}
+
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to sign zone: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
+func (c *Client) UnsignDnsZone(zone string, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/dnssec/unsign")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to unsign zone: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
+func (c *Client) GetDnsZoneDnssecProperties(zone string, ctx context.Context) (DnsZoneDnssecProperties, error) {
+
+	req, err := c.GetRequest("/api/zones/dnssec/properties/get")
+	if err != nil {
+		return DnsZoneDnssecProperties{}, err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return DnsZoneDnssecProperties{}, err
+	}
+
+	response := DnsZoneDnssecPropertiesResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return DnsZoneDnssecProperties{}, err
+	}
+
+	if response.Status != "ok" {
+		return DnsZoneDnssecProperties{}, fmt.Errorf("failed to get DNSSEC properties: %s", response.ErrorMessage)
+	}
+
+	return response.Response, nil
+}
+
+func (c *Client) GetDnsZoneDsRecords(zone string, ctx context.Context) ([]DsRecord, error) {
+
+	req, err := c.GetRequest("/api/zones/dnssec/viewDS")
+	if err != nil {
+		return nil, err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := DnsZoneDsRecordsResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Status != "ok" {
+		return nil, fmt.Errorf("failed to get DS records: %s", response.ErrorMessage)
+	}
+
+	return response.Response.DsRecords, nil
+}
+
+func (c *Client) ConvertDnsZoneToNsec(zone string, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/dnssec/properties/convertToNSEC")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to convert zone to NSEC: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
+func (c *Client) ConvertDnsZoneToNsec3(zone string, iterations int64, saltLength int64, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/dnssec/properties/convertToNSEC3")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	params.Add("iterations", fmt.Sprintf("%d", iterations))
+	params.Add("saltLength", fmt.Sprintf("%d", saltLength))
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to convert zone to NSEC3: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateDnsZoneNsec3Parameters(zone string, iterations int64, saltLength int64, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/dnssec/properties/updateNSEC3Params")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	params.Add("iterations", fmt.Sprintf("%d", iterations))
+	params.Add("saltLength", fmt.Sprintf("%d", saltLength))
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to update NSEC3 parameters: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateDnsZoneDnsKeyTTL(zone string, ttl int64, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/dnssec/properties/updateDnsKeyTtl")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	params.Add("ttl", fmt.Sprintf("%d", ttl))
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to update DNSKEY TTL: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
+func (c *Client) AddDnssecPrivateKey(k DnssecPrivateKeyCreate, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/dnssec/properties/addPrivateKey")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", k.Zone)
+	params.Add("keyType", k.KeyType)
+	params.Add("algorithm", k.Algorithm)
+
+	if k.RolloverDays != 0 {
+		params.Add("rolloverDays", fmt.Sprintf("%d", k.RolloverDays))
+	}
+	if k.HashAlgorithm != "" {
+		params.Add("hashAlgorithm", k.HashAlgorithm)
+	}
+	if k.KeySize != 0 {
+		params.Add("keySize", fmt.Sprintf("%d", k.KeySize))
+	}
+	if k.Curve != "" {
+		params.Add("curve", k.Curve)
+	}
+
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to add private key: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
+func (c *Client) UpdateDnssecPrivateKey(zone string, keyTag int64, rolloverDays int64, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/dnssec/properties/updatePrivateKey")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	params.Add("keyTag", fmt.Sprintf("%d", keyTag))
+	params.Add("rolloverDays", fmt.Sprintf("%d", rolloverDays))
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to update private key: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
+
+// dnssecKeyAction calls one of the DNSSEC properties endpoints that act on a single key.
+func (c *Client) dnssecKeyAction(endpoint string, action string, zone string, keyTag int64, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/dnssec/properties/" + endpoint)
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	params.Add("keyTag", fmt.Sprintf("%d", keyTag))
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to %s key %d: %s", action, keyTag, response.ErrorMessage)
+	}
+
+	return nil
+}
+
+// DeleteDnssecPrivateKey deletes a private key that has not been published yet.
+func (c *Client) DeleteDnssecPrivateKey(zone string, keyTag int64, ctx context.Context) error {
+	return c.dnssecKeyAction("deletePrivateKey", "delete", zone, keyTag, ctx)
+}
+
+// RolloverDnsKey generates a replacement for the key and retires it once the new key is active.
+func (c *Client) RolloverDnsKey(zone string, keyTag int64, ctx context.Context) error {
+	return c.dnssecKeyAction("rolloverDnsKey", "roll over", zone, keyTag, ctx)
+}
+
+// RetireDnsKey retires an active key. The server refuses to retire the last active key of a type.
+func (c *Client) RetireDnsKey(zone string, keyTag int64, ctx context.Context) error {
+	return c.dnssecKeyAction("retireDnsKey", "retire", zone, keyTag, ctx)
+}
+
+// PublishAllDnssecPrivateKeys publishes the DNSKEY records of every generated private key in the zone.
+func (c *Client) PublishAllDnssecPrivateKeys(zone string, ctx context.Context) error {
+
+	req, err := c.GetRequest("/api/zones/dnssec/properties/publishAllPrivateKeys")
+	if err != nil {
+		return err
+	}
+
+	params := req.URL.Query()
+	params.Add("zone", zone)
+	req.URL.RawQuery = params.Encode()
+
+	body, err := c.doRequest(req, ctx)
+	if err != nil {
+		return err
+	}
+
+	response := BaseResponse{}
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return err
+	}
+
+	if response.Status != "ok" {
+		return fmt.Errorf("failed to publish private keys: %s", response.ErrorMessage)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package technitium
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"terraform-provider-technitium/internal/test"
+	"testing"
+)
+
+func TestClient_SignDnsZone(t *testing.T) {
+	ctx := context.Background()
+	sign := DnsZoneDnssecSign{Zone: "example.com", Algorithm: "ECDSA", Curve: "P256", NxProof: "NSEC3"}
+
+	t.Run("successful sign", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"ok"}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.SignDnsZone(sign, ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("api error on sign", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"error", "errorMessage":"The zone is already signed."}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.SignDnsZone(sign, ctx)
+		if err == nil {
+			t.Fatal("Expected API error, got nil")
+		}
+		if !strings.Contains(err.Error(), "failed to sign zone: The zone is already signed.") {
+			t.Errorf("Error message mismatch, got %v", err)
+		}
+	})
+}
+
+func TestClient_GetDnsZoneDsRecords(t *testing.T) {
+	ctx := context.Background()
+
+	scenario := test.GetMockScenarioFromFile(t, "../test/mocks/dns_zone_ds_response.json", http.StatusOK)
+	client, cleanup := GetMockClient(scenario)
+	defer cleanup()
+
+	records, err := client.GetDnsZoneDsRecords("example.com", ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(records) != 1 {
+		t.Fatalf("Expected 1 DS record, got %d", len(records))
+	}
+
+	record := records[0]
+	if record.KeyTag != 31337 || record.Algorithm != "ECDSAP256SHA256" {
+		t.Errorf("Unexpected DS record %+v", record)
+	}
+	if len(record.Digests) != 2 || record.Digests[0].DigestType != "SHA256" {
+		t.Errorf("Unexpected DS digests %+v", record.Digests)
+	}
+	if DnssecAlgorithmNumbers[record.Algorithm] != 13 {
+		t.Errorf("Expected algorithm number 13, got %d", DnssecAlgorithmNumbers[record.Algorithm])
+	}
+}
+
+func TestClient_GetDnsZoneDnssecProperties(t *testing.T) {
+	ctx := context.Background()
+
+	scenario := test.GetMockScenarioFromFile(t, "../test/mocks/dns_zone_dnssec_properties_response.json", http.StatusOK)
+	client, cleanup := GetMockClient(scenario)
+	defer cleanup()
+
+	properties, err := client.GetDnsZoneDnssecProperties("example.com", ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if properties.DnssecStatus != "SignedWithNSEC" || properties.DnsKeyTTL != 3600 {
+		t.Errorf("Unexpected properties %+v", properties)
+	}
+
+	if len(properties.DnssecPrivateKeys) != 2 {
+		t.Fatalf("Expected 2 private keys, got %d", len(properties.DnssecPrivateKeys))
+	}
+
+	zsk := properties.DnssecPrivateKeys[1]
+	if zsk.KeyTag != 4711 || zsk.KeyType != "ZoneSigningKey" || zsk.RolloverDays != 30 {
+		t.Errorf("Unexpected private key %+v", zsk)
+	}
+}
+
+func TestClient_GetDnsZoneDnssecProperties_nsec3(t *testing.T) {
+	ctx := context.Background()
+
+	scenario := test.GetMockScenarioFromFile(t, "../test/mocks/dns_zone_dnssec_nsec3_properties_response.json", http.StatusOK)
+	client, cleanup := GetMockClient(scenario)
+	defer cleanup()
+
+	properties, err := client.GetDnsZoneDnssecProperties("example.com", ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if properties.DnssecStatus != "SignedWithNSEC3" || properties.Nsec3Iterations != 5 || properties.Nsec3SaltLength != 8 {
+		t.Errorf("Unexpected properties %+v", properties)
+	}
+}
+
+func TestClient_RetireDnsKey(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful retire", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"ok"}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.RetireDnsKey("example.com", 4711, ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("api error on retire", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"error", "errorMessage":"Cannot retire the only active key."}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.RetireDnsKey("example.com", 4711, ctx)
+		if err == nil {
+			t.Fatal("Expected API error, got nil")
+		}
+		if !strings.Contains(err.Error(), "failed to retire key 4711: Cannot retire the only active key.") {
+			t.Errorf("Error message mismatch, got %v", err)
+		}
+	})
+}
@@ -3,13 +3,94 @@ package technitium
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// recordDataTypes lists the record types whose rData is fully described by DnsZoneRecordData. The rData of
+// other types, such as DNSKEY or RRSIG in signed zones, is decoded as far as the property types allow.
+var recordDataTypes = map[string]bool{
+	"SOA": true, "A": true, "AAAA": true, "NS": true, "CNAME": true, "PTR": true, "MX": true, "TXT": true,
+	"SRV": true, "NAPTR": true, "DNAME": true, "DS": true, "SSHFP": true, "TLSA": true, "SVCB": true,
+	"HTTPS": true, "URI": true, "CAA": true, "ANAME": true, "FWD": true, "APP": true,
+}
+
+// UnmarshalJSON decodes the rData according to the record type, as the flags and protocol properties
+// have a different JSON type depending on the record type.
+func (r *DnsZoneRecord) UnmarshalJSON(data []byte) error {
+
+	type record DnsZoneRecord
+	aux := struct {
+		*record
+		RecordData json.RawMessage `json:"rData"`
+	}{record: (*record)(r)}
+
+	err := json.Unmarshal(data, &aux)
+	if err != nil {
+		return err
+	}
+
+	r.RecordData = DnsZoneRecordData{}
+	if len(aux.RecordData) == 0 || string(aux.RecordData) == "null" {
+		return nil
+	}
+
+	err = json.Unmarshal(aux.RecordData, &r.RecordData)
+	var typeError *json.UnmarshalTypeError
+	if err != nil && (recordDataTypes[r.Type] || !errors.As(err, &typeError)) {
+		return fmt.Errorf("failed to decode %s record data: %w", r.Type, err)
+	}
+
+	err = nil
+	switch r.Type {
+	case "FWD":
+		var fwd struct {
+			Protocol string `json:"protocol"`
+		}
+		err = json.Unmarshal(aux.RecordData, &fwd)
+		r.RecordData.Protocol = fwd.Protocol
+	case "CAA":
+		var caa struct {
+			Flags int64 `json:"flags"`
+		}
+		err = json.Unmarshal(aux.RecordData, &caa)
+		r.RecordData.Flags = caa.Flags
+	case "NAPTR":
+		var naptr struct {
+			Flags string `json:"flags"`
+		}
+		err = json.Unmarshal(aux.RecordData, &naptr)
+		r.RecordData.NaptrFlags = naptr.Flags
+	}
+	if err != nil {
+		return fmt.Errorf("failed to decode %s record data: %w", r.Type, err)
+	}
+
+	return nil
+}
+
+// FormatSvcParams encodes SVCB and HTTPS parameters in the pipe separated form of the API, such as
+// `alpn|h2,h3|port|443`, sorted by key.
+func FormatSvcParams(svcParams map[string]string) string {
+	keys := make([]string, 0, len(svcParams))
+	for key := range svcParams {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, 2*len(keys))
+	for _, key := range keys {
+		parts = append(parts, key, svcParams[key])
+	}
+
+	return strings.Join(parts, "|")
+}
+
 func (c *Client) GetDnsZoneRecords(domain string, ctx context.Context) ([]DnsZoneRecord, error) {
 	url := fmt.Sprintf("%s/api/zones/records/get?domain=%s", c.HostURL, domain)
 
@@ -60,6 +141,41 @@ func (c *Client) GetDnsZoneRecord(domain string, recordType string, ctx context.
 
 }
 
+// GetDnsZoneRecordSet returns every record with the given name and type, which is empty when there are none.
+func (c *Client) GetDnsZoneRecordSet(domain string, recordType string, ctx context.Context) ([]DnsZoneRecord, error) {
+	records, err := c.GetDnsZoneRecords(domain, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get DNS zone record set: %w", err)
+	}
+
+	set := make([]DnsZoneRecord, 0, len(records))
+	for _, record := range records {
+		if record.Type == recordType && strings.EqualFold(record.Name, domain) {
+			set = append(set, record)
+		}
+	}
+
+	return set, nil
+}
+
+// GetDnsZoneRecordByValue returns the record with the given name, type and record value as returned by
+// RecordValue. An empty value matches the first record of the name and type.
+func (c *Client) GetDnsZoneRecordByValue(domain string, recordType string, value string, ctx context.Context) (DnsZoneRecord, error) {
+	records, err := c.GetDnsZoneRecordSet(domain, recordType, ctx)
+	if err != nil {
+		return DnsZoneRecord{}, err
+	}
+
+	for _, record := range records {
+		if value == "" || RecordValue(record) == value {
+			tflog.Debug(ctx, fmt.Sprintf("Found DNS zone record: %+v", record))
+			return record, nil
+		}
+	}
+
+	return DnsZoneRecord{}, fmt.Errorf("no DNS zone record found for domain: %s with type: %s and value: %s", domain, recordType, value)
+}
+
 func (c *Client) CreateDnsZoneRecord(r DnsZoneRecordCreate, ctx context.Context) error {
 
 	req, err := c.GetRequest("/api/zones/records/add")
@@ -80,6 +196,8 @@ func (c *Client) CreateDnsZoneRecord(r DnsZoneRecordCreate, ctx context.Context)
 	params.Add("updateSvcbHints", fmt.Sprintf("%t", r.UpdateSvcbHints))
 	params.Add("nameServer", r.NameServer)
 	params.Add("cname", r.Cname)
+	params.Add("aname", r.Aname)
+	params.Add("dname", r.Dname)
 	params.Add("ptrName", r.PtrName)
 	params.Add("exchange", r.Exchange)
 	params.Add("preference", fmt.Sprintf("%d", r.Preference))
@@ -97,6 +215,36 @@ func (c *Client) CreateDnsZoneRecord(r DnsZoneRecordCreate, ctx context.Context)
 	params.Add("appName", r.AppName)
 	params.Add("classPath", r.ClassPath)
 	params.Add("recordData", r.RecordData)
+	params.Add("priority", fmt.Sprintf("%d", r.Priority))
+	params.Add("weight", fmt.Sprintf("%d", r.Weight))
+	params.Add("port", fmt.Sprintf("%d", r.Port))
+	params.Add("target", r.Target)
+	params.Add("flags", fmt.Sprintf("%d", r.Flags))
+	params.Add("tag", r.Tag)
+	params.Add("value", r.Value)
+	params.Add("svcPriority", fmt.Sprintf("%d", r.SvcPriority))
+	params.Add("svcTargetName", r.SvcTargetName)
+	params.Add("svcParams", FormatSvcParams(r.SvcParams))
+	params.Add("keyTag", fmt.Sprintf("%d", r.KeyTag))
+	params.Add("algorithm", r.Algorithm)
+	params.Add("digestType", r.DigestType)
+	params.Add("digest", r.Digest)
+	params.Add("sshfpAlgorithm", r.SshfpAlgorithm)
+	params.Add("sshfpFingerprintType", r.SshfpFingerprintType)
+	params.Add("sshfpFingerprint", r.SshfpFingerprint)
+	params.Add("tlsaCertificateUsage", r.TlsaCertificateUsage)
+	params.Add("tlsaSelector", r.TlsaSelector)
+	params.Add("tlsaMatchingType", r.TlsaMatchingType)
+	params.Add("tlsaCertificateAssociationData", r.TlsaCertificateAssociationData)
+	params.Add("naptrOrder", fmt.Sprintf("%d", r.NaptrOrder))
+	params.Add("naptrPreference", fmt.Sprintf("%d", r.NaptrPreference))
+	params.Add("naptrFlags", r.NaptrFlags)
+	params.Add("naptrServices", r.NaptrServices)
+	params.Add("naptrRegexp", r.NaptrRegexp)
+	params.Add("naptrReplacement", r.NaptrReplacement)
+	params.Add("uriPriority", fmt.Sprintf("%d", r.UriPriority))
+	params.Add("uriWeight", fmt.Sprintf("%d", r.UriWeight))
+	params.Add("uri", r.Uri)
 
 	req.URL.RawQuery = params.Encode()
 
@@ -142,6 +290,9 @@ func (c *Client) UpdateDnsZoneRecord(r DnsZoneRecordUpdate, ctx context.Context)
 	params.Add("nameServer", r.NameServer)
 	params.Add("newNameServer", r.NewNameServer)
 	params.Add("cname", r.Cname)
+	params.Add("aname", r.Aname)
+	params.Add("newAname", r.NewAname)
+	params.Add("dname", r.Dname)
 	params.Add("ptrName", r.PtrName)
 	params.Add("newPtrName", r.NewPtrName)
 	params.Add("exchange", r.Exchange)
@@ -165,6 +316,66 @@ func (c *Client) UpdateDnsZoneRecord(r DnsZoneRecordUpdate, ctx context.Context)
 	params.Add("appName", r.AppName)
 	params.Add("classPath", r.ClassPath)
 	params.Add("recordData", r.RecordData)
+	params.Add("priority", fmt.Sprintf("%d", r.Priority))
+	params.Add("newPriority", fmt.Sprintf("%d", r.NewPriority))
+	params.Add("weight", fmt.Sprintf("%d", r.Weight))
+	params.Add("newWeight", fmt.Sprintf("%d", r.NewWeight))
+	params.Add("port", fmt.Sprintf("%d", r.Port))
+	params.Add("newPort", fmt.Sprintf("%d", r.NewPort))
+	params.Add("target", r.Target)
+	params.Add("newTarget", r.NewTarget)
+	params.Add("flags", fmt.Sprintf("%d", r.Flags))
+	params.Add("newFlags", fmt.Sprintf("%d", r.NewFlags))
+	params.Add("tag", r.Tag)
+	params.Add("newTag", r.NewTag)
+	params.Add("value", r.Value)
+	params.Add("newValue", r.NewValue)
+	params.Add("svcPriority", fmt.Sprintf("%d", r.SvcPriority))
+	params.Add("newSvcPriority", fmt.Sprintf("%d", r.NewSvcPriority))
+	params.Add("svcTargetName", r.SvcTargetName)
+	params.Add("newSvcTargetName", r.NewSvcTargetName)
+	params.Add("svcParams", FormatSvcParams(r.SvcParams))
+	params.Add("newSvcParams", FormatSvcParams(r.NewSvcParams))
+	params.Add("keyTag", fmt.Sprintf("%d", r.KeyTag))
+	params.Add("newKeyTag", fmt.Sprintf("%d", r.NewKeyTag))
+	params.Add("algorithm", r.Algorithm)
+	params.Add("newAlgorithm", r.NewAlgorithm)
+	params.Add("digestType", r.DigestType)
+	params.Add("newDigestType", r.NewDigestType)
+	params.Add("digest", r.Digest)
+	params.Add("newDigest", r.NewDigest)
+	params.Add("sshfpAlgorithm", r.SshfpAlgorithm)
+	params.Add("newSshfpAlgorithm", r.NewSshfpAlgorithm)
+	params.Add("sshfpFingerprintType", r.SshfpFingerprintType)
+	params.Add("newSshfpFingerprintType", r.NewSshfpFingerprintType)
+	params.Add("sshfpFingerprint", r.SshfpFingerprint)
+	params.Add("newSshfpFingerprint", r.NewSshfpFingerprint)
+	params.Add("tlsaCertificateUsage", r.TlsaCertificateUsage)
+	params.Add("newTlsaCertificateUsage", r.NewTlsaCertificateUsage)
+	params.Add("tlsaSelector", r.TlsaSelector)
+	params.Add("newTlsaSelector", r.NewTlsaSelector)
+	params.Add("tlsaMatchingType", r.TlsaMatchingType)
+	params.Add("newTlsaMatchingType", r.NewTlsaMatchingType)
+	params.Add("tlsaCertificateAssociationData", r.TlsaCertificateAssociationData)
+	params.Add("newTlsaCertificateAssociationData", r.NewTlsaCertificateAssociationData)
+	params.Add("naptrOrder", fmt.Sprintf("%d", r.NaptrOrder))
+	params.Add("newNaptrOrder", fmt.Sprintf("%d", r.NewNaptrOrder))
+	params.Add("naptrPreference", fmt.Sprintf("%d", r.NaptrPreference))
+	params.Add("newNaptrPreference", fmt.Sprintf("%d", r.NewNaptrPreference))
+	params.Add("naptrFlags", r.NaptrFlags)
+	params.Add("newNaptrFlags", r.NewNaptrFlags)
+	params.Add("naptrServices", r.NaptrServices)
+	params.Add("newNaptrServices", r.NewNaptrServices)
+	params.Add("naptrRegexp", r.NaptrRegexp)
+	params.Add("newNaptrRegexp", r.NewNaptrRegexp)
+	params.Add("naptrReplacement", r.NaptrReplacement)
+	params.Add("newNaptrReplacement", r.NewNaptrReplacement)
+	params.Add("uriPriority", fmt.Sprintf("%d", r.UriPriority))
+	params.Add("newUriPriority", fmt.Sprintf("%d", r.NewUriPriority))
+	params.Add("uriWeight", fmt.Sprintf("%d", r.UriWeight))
+	params.Add("newUriWeight", fmt.Sprintf("%d", r.NewUriWeight))
+	params.Add("uri", r.Uri)
+	params.Add("newUri", r.NewUri)
 
 	req.URL.RawQuery = params.Encode()
 
@@ -199,6 +410,71 @@ func (c *Client) DeleteDnsZoneRecord(r DnsZoneRecordCreate, ctx context.Context)
 	params.Add("zone", r.Zone)
 	params.Add("ipAddress", r.IPAddress)
 	params.Add("ptrName", r.PtrName)
+
+	// The record data selects which record of the name and type is deleted
+	if r.NameServer != "" {
+		params.Add("nameServer", r.NameServer)
+	}
+	if r.Aname != "" {
+		params.Add("aname", r.Aname)
+	}
+	if r.Exchange != "" {
+		params.Add("exchange", r.Exchange)
+		params.Add("preference", fmt.Sprintf("%d", r.Preference))
+	}
+	if r.Text != "" {
+		params.Add("text", r.Text)
+	}
+	if r.Forwarder != "" {
+		params.Add("protocol", r.Protocol)
+		params.Add("forwarder", r.Forwarder)
+	}
+	if r.Target != "" {
+		params.Add("priority", fmt.Sprintf("%d", r.Priority))
+		params.Add("weight", fmt.Sprintf("%d", r.Weight))
+		params.Add("port", fmt.Sprintf("%d", r.Port))
+		params.Add("target", r.Target)
+	}
+	if r.Tag != "" {
+		params.Add("flags", fmt.Sprintf("%d", r.Flags))
+		params.Add("tag", r.Tag)
+		params.Add("value", r.Value)
+	}
+	if r.Type == "SVCB" || r.Type == "HTTPS" {
+		params.Add("svcPriority", fmt.Sprintf("%d", r.SvcPriority))
+		params.Add("svcTargetName", r.SvcTargetName)
+		params.Add("svcParams", FormatSvcParams(r.SvcParams))
+	}
+	if r.Digest != "" {
+		params.Add("keyTag", fmt.Sprintf("%d", r.KeyTag))
+		params.Add("algorithm", r.Algorithm)
+		params.Add("digestType", r.DigestType)
+		params.Add("digest", r.Digest)
+	}
+	if r.SshfpFingerprint != "" {
+		params.Add("sshfpAlgorithm", r.SshfpAlgorithm)
+		params.Add("sshfpFingerprintType", r.SshfpFingerprintType)
+		params.Add("sshfpFingerprint", r.SshfpFingerprint)
+	}
+	if r.TlsaCertificateAssociationData != "" {
+		params.Add("tlsaCertificateUsage", r.TlsaCertificateUsage)
+		params.Add("tlsaSelector", r.TlsaSelector)
+		params.Add("tlsaMatchingType", r.TlsaMatchingType)
+		params.Add("tlsaCertificateAssociationData", r.TlsaCertificateAssociationData)
+	}
+	if r.Type == "NAPTR" {
+		params.Add("naptrOrder", fmt.Sprintf("%d", r.NaptrOrder))
+		params.Add("naptrPreference", fmt.Sprintf("%d", r.NaptrPreference))
+		params.Add("naptrFlags", r.NaptrFlags)
+		params.Add("naptrServices", r.NaptrServices)
+		params.Add("naptrRegexp", r.NaptrRegexp)
+		params.Add("naptrReplacement", r.NaptrReplacement)
+	}
+	if r.Uri != "" {
+		params.Add("uriPriority", fmt.Sprintf("%d", r.UriPriority))
+		params.Add("uriWeight", fmt.Sprintf("%d", r.UriWeight))
+		params.Add("uri", r.Uri)
+	}
 	req.URL.RawQuery = params.Encode()
 
 	body, err := c.doRequest(req, ctx)
@@ -89,6 +89,45 @@ func TestClient_GetDnsZoneRecords(t *testing.T) {
 	})
 }
 
+func TestClient_GetDnsZoneRecords_recordData(t *testing.T) {
+	ctx := context.Background()
+
+	scenario := test.GetMockScenarioFromFile(t, "../test/mocks/dns_zone_records_typed_response.json", http.StatusOK)
+	client, cleanup := GetMockClient(scenario)
+	defer cleanup()
+
+	records, err := client.GetDnsZoneRecords("example.com", ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []DnsZoneRecordData{
+		{Preference: 10, Exchange: "mail.example.com"},
+		{Text: "v=spf1 mx -all"},
+		{Flags: 0, Tag: "issue", Value: "letsencrypt.org"},
+		{Priority: 10, Weight: 60, Port: 5060, Target: "sip.example.com"},
+		{Order: 100, Preference: 10, NaptrFlags: "S", Services: "SIP+D2U", Replacement: "_sip._udp.example.com"},
+		{Protocol: "Udp", Forwarder: "192.0.2.53", ProxyType: "DefaultProxy"},
+		{SvcPriority: 1, SvcMode: "ServiceMode", SvcParams: map[string]string{"alpn": "h2,h3"}},
+		// The flags and protocol of types without a model are not decoded
+		{Algorithm: "ECDSAP256SHA256"},
+	}
+
+	if len(records) != len(expected) {
+		t.Fatalf("Expected %d records, got %d", len(expected), len(records))
+	}
+	for i, record := range records {
+		if !reflect.DeepEqual(record.RecordData, expected[i]) {
+			t.Errorf("%s record:", record.Type)
+			test.PrintPrettyDeepEqualError(t, expected[i], record.RecordData)
+		}
+	}
+
+	if records[0].Comments != "primary mail" {
+		t.Errorf("Expected comments to be decoded, got %q", records[0].Comments)
+	}
+}
+
 func TestClient_GetDnsZoneRecord(t *testing.T) {
 	ctx := context.Background()
 	domain := "example.com"
@@ -183,6 +222,99 @@ func TestClient_GetDnsZoneRecord(t *testing.T) {
 	})
 }
 
+func TestClient_GetDnsZoneRecordSet(t *testing.T) {
+	ctx := context.Background()
+	domain := "www.example.com"
+
+	t.Run("only records of the name and type", func(t *testing.T) {
+		mockResponseBody := `{"response":{"records":[` +
+			`{"name":"www.example.com","type":"A","rData":{"ipAddress":"192.0.2.1"}},` +
+			`{"name":"WWW.example.com","type":"A","rData":{"ipAddress":"192.0.2.2"}},` +
+			`{"name":"www.example.com","type":"AAAA","rData":{"ipAddress":"2001:db8::1"}},` +
+			`{"name":"mail.www.example.com","type":"A","rData":{"ipAddress":"192.0.2.3"}}]}}`
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   mockResponseBody,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		records, err := client.GetDnsZoneRecordSet(domain, "A", ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(records) != 2 {
+			t.Fatalf("Expected 2 records, got %d", len(records))
+		}
+		if records[0].RecordData.IpAddress != "192.0.2.1" || records[1].RecordData.IpAddress != "192.0.2.2" {
+			t.Errorf("Unexpected records %+v", records)
+		}
+	})
+
+	t.Run("no records of the type", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"response":{"records":[{"name":"www.example.com","type":"A","rData":{"ipAddress":"192.0.2.1"}}]}}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		records, err := client.GetDnsZoneRecordSet(domain, "MX", ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(records) != 0 {
+			t.Errorf("Expected no records, got %+v", records)
+		}
+	})
+
+	t.Run("error from GetDnsZoneRecords", func(t *testing.T) {
+		mockScenario := test.Scenario{ExpectedError: fmt.Errorf("simulated GetDnsZoneRecords error")}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		_, err := client.GetDnsZoneRecordSet(domain, "A", ctx)
+		if err == nil || !strings.Contains(err.Error(), "failed to get DNS zone record set") {
+			t.Errorf("Expected record set error, got %v", err)
+		}
+	})
+}
+
+func TestClient_GetDnsZoneRecordByValue(t *testing.T) {
+	ctx := context.Background()
+	domain := "example.com"
+	mockResponseBody := `{"response":{"records":[` +
+		`{"name":"example.com","type":"MX","ttl":300,"rData":{"preference":10,"exchange":"mail1.example.com"}},` +
+		`{"name":"example.com","type":"MX","ttl":600,"rData":{"preference":20,"exchange":"mail2.example.com"}}]}}`
+
+	t.Run("record with the value", func(t *testing.T) {
+		client, cleanup := GetMockClient(test.Scenario{ExpectedStatus: http.StatusOK, ExpectedBody: mockResponseBody})
+		defer cleanup()
+
+		record, err := client.GetDnsZoneRecordByValue(domain, "MX", "20 mail2.example.com", ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if record.TTL != 600 {
+			t.Errorf("Expected the second record, got %+v", record)
+		}
+	})
+
+	t.Run("value was removed", func(t *testing.T) {
+		client, cleanup := GetMockClient(test.Scenario{ExpectedStatus: http.StatusOK, ExpectedBody: mockResponseBody})
+		defer cleanup()
+
+		_, err := client.GetDnsZoneRecordByValue(domain, "MX", "30 mail3.example.com", ctx)
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+		expectedErrMsg := "no DNS zone record found for domain: example.com with type: MX and value: 30 mail3.example.com"
+		if !strings.Contains(err.Error(), expectedErrMsg) {
+			t.Errorf("Expected error message '%s', got '%v'", expectedErrMsg, err)
+		}
+	})
+}
+
 func TestClient_CreateDnsZoneRecord(t *testing.T) {
 	ctx := context.Background()
 	recordCreate := DnsZoneRecordCreate{Domain: "new.example.com", Type: "A", Zone: "example.com", IPAddress: "192.0.2.10"}
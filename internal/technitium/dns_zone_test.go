@@ -0,0 +1,498 @@
+package technitium
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"terraform-provider-technitium/internal/test"
+	"testing"
+)
+
+func TestClient_GetDnsZone(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful retrieval", func(t *testing.T) {
+		scenario := test.GetMockScenarioFromFile(t, "../test/mocks/dns_zone_response.json", http.StatusOK)
+		client, cleanup := GetMockClient(scenario)
+		defer cleanup()
+
+		zone, err := client.GetDnsZone("example3.com", ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if zone.Name != "example3.com" || zone.Type != "Primary" {
+			t.Errorf("Unexpected zone %+v", zone)
+		}
+		if zone.ZoneTransfer != "AllowOnlyZoneNameServers" {
+			t.Errorf("Expected zone transfer 'AllowOnlyZoneNameServers', got '%s'", zone.ZoneTransfer)
+		}
+	})
+
+	t.Run("zone not found", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"error", "errorMessage":"No such zone was found: missing.com"}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		_, err := client.GetDnsZone("missing.com", ctx)
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+		if !strings.Contains(err.Error(), "failed to get zone: No such zone was found") {
+			t.Errorf("Error message mismatch, got %v", err)
+		}
+	})
+}
+
+func TestClient_SetDnsZoneOptions(t *testing.T) {
+	ctx := context.Background()
+	tsigKeyName := ""
+	options := DnsZoneOptions{
+		Zone:                           "example.com",
+		PrimaryZoneTransferTsigKeyName: &tsigKeyName,
+		ZoneTransfer:                   "UseSpecifiedNetworkACL",
+		ZoneTransferNetworkAcl:         []string{"192.0.2.0/24", "!192.0.2.1"},
+		NotifyNameServers:              []string{},
+	}
+
+	t.Run("successful update", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"ok"}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.SetDnsZoneOptions(options, ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("empty values are sent", func(t *testing.T) {
+		var query map[string][]string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query()
+			fmt.Fprint(w, `{"status":"ok"}`)
+		}))
+		defer server.Close()
+
+		client := &Client{HostURL: server.URL, HTTPClient: server.Client(), Token: "test-token"}
+
+		err := client.SetDnsZoneOptions(options, ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if values, ok := query["primaryZoneTransferTsigKeyName"]; !ok || values[0] != "" {
+			t.Errorf("Expected an empty TSIG key name, got %v", query)
+		}
+		if _, ok := query["primaryZoneTransferProtocol"]; ok {
+			t.Errorf("Expected no transfer protocol, got %v", query)
+		}
+	})
+
+	t.Run("api error on update", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"error", "errorMessage":"invalid option"}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.SetDnsZoneOptions(options, ctx)
+		if err == nil {
+			t.Fatal("Expected API error, got nil")
+		}
+		if !strings.Contains(err.Error(), "failed to set zone options: invalid option") {
+			t.Errorf("Error message mismatch, got %v", err)
+		}
+	})
+}
+
+func TestClient_DisableDnsZone(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful disable", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"ok"}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.DisableDnsZone("example.com", ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("api error on disable", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"error", "errorMessage":"No such zone was found: example.com"}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.DisableDnsZone("example.com", ctx)
+		if err == nil {
+			t.Fatal("Expected API error, got nil")
+		}
+		if !strings.Contains(err.Error(), "failed to disable zone: No such zone was found") {
+			t.Errorf("Error message mismatch, got %v", err)
+		}
+	})
+}
+
+func TestClient_CloneDnsZone(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful clone", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"ok"}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.CloneDnsZone("staging.example.com", "example.com", ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("api error on clone", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"error", "errorMessage":"Zone already exists: staging.example.com"}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.CloneDnsZone("staging.example.com", "example.com", ctx)
+		if err == nil {
+			t.Fatal("Expected API error, got nil")
+		}
+		if !strings.Contains(err.Error(), "failed to clone zone: Zone already exists") {
+			t.Errorf("Error message mismatch, got %v", err)
+		}
+	})
+}
+
+func TestClient_ImportDnsZone(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful import", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"ok"}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.ImportDnsZone("example.com", "www 3600 IN A 192.0.2.1\n", true, false, ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("api error on import", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"error", "errorMessage":"Failed to parse zone file"}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.ImportDnsZone("example.com", "www IN BOGUS", false, false, ctx)
+		if err == nil {
+			t.Fatal("Expected API error, got nil")
+		}
+		if !strings.Contains(err.Error(), "failed to import zone: Failed to parse zone file") {
+			t.Errorf("Error message mismatch, got %v", err)
+		}
+	})
+}
+
+func TestClient_ExportDnsZone(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful export", func(t *testing.T) {
+		scenario := test.GetMockScenarioFromFile(t, "../test/mocks/dns_zone_export_response.txt", http.StatusOK)
+		client, cleanup := GetMockClient(scenario)
+		defer cleanup()
+
+		content, err := client.ExportDnsZone("example.com", ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !strings.Contains(content, "www.example.com.\t3600\tIN\tA\t192.0.2.1") {
+			t.Errorf("Unexpected zone file content:\n%s", content)
+		}
+	})
+
+	t.Run("api error on export", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"error", "errorMessage":"No such zone was found: missing.com"}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		_, err := client.ExportDnsZone("missing.com", ctx)
+		if err == nil {
+			t.Fatal("Expected API error, got nil")
+		}
+		if !strings.Contains(err.Error(), "failed to export zone: No such zone was found") {
+			t.Errorf("Error message mismatch, got %v", err)
+		}
+	})
+}
+
+func TestClient_GetDnsZones(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("single page", func(t *testing.T) {
+		scenario := test.GetMockScenarioFromFile(t, "../test/mocks/dns_zones_response.json", http.StatusOK)
+		client, cleanup := GetMockClient(scenario)
+		defer cleanup()
+
+		zones, err := client.GetDnsZones(0, ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(zones) != 6 {
+			t.Errorf("Expected 6 zones, got %d", len(zones))
+		}
+	})
+
+	t.Run("multiple pages", func(t *testing.T) {
+		var requests []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			query := r.URL.Query()
+			requests = append(requests, query.Get("pageNumber")+"/"+query.Get("zonesPerPage"))
+			fmt.Fprintf(w, `{"status":"ok","response":{"pageNumber":%s,"totalPages":3,"totalZones":5,"zones":[{"name":"zone%s.example"}]}}`,
+				query.Get("pageNumber"), query.Get("pageNumber"))
+		}))
+		defer server.Close()
+
+		client := &Client{HostURL: server.URL, HTTPClient: server.Client(), Token: "test-token"}
+
+		zones, err := client.GetDnsZones(2, ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(zones) != 3 || zones[2].Name != "zone3.example" {
+			t.Errorf("Unexpected zones %+v", zones)
+		}
+		if strings.Join(requests, ",") != "1/2,2/2,3/2" {
+			t.Errorf("Unexpected page requests %v", requests)
+		}
+	})
+
+	t.Run("api error on list", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"error", "errorMessage":"Access was denied."}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		_, err := client.GetDnsZones(10, ctx)
+		if err == nil {
+			t.Fatal("Expected API error, got nil")
+		}
+		if !strings.Contains(err.Error(), "failed to list zones: Access was denied.") {
+			t.Errorf("Error message mismatch, got %v", err)
+		}
+	})
+}
+
+func TestClient_GetCatalogZoneMembers(t *testing.T) {
+	ctx := context.Background()
+
+	mockScenario := test.Scenario{
+		ExpectedStatus: http.StatusOK,
+		ExpectedBody: `{"status":"ok","response":{"zones":[
+			{"name":"catalog.example","type":"Catalog"},
+			{"name":"a.example","type":"Primary","catalog":"catalog.example"},
+			{"name":"b.example","type":"Primary"},
+			{"name":"c.example","type":"Forwarder","catalog":"Catalog.Example"}]}}`,
+	}
+	client, cleanup := GetMockClient(mockScenario)
+	defer cleanup()
+
+	members, err := client.GetCatalogZoneMembers("catalog.example", ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if strings.Join(members, ",") != "a.example,c.example" {
+		t.Errorf("Unexpected members %v", members)
+	}
+}
+
+func TestClient_ResyncDnsZone(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful resync", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"ok"}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.ResyncDnsZone("secondary.example", ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("api error on resync", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"error", "errorMessage":"Only Secondary, Stub, and Secondary Forwarder zones support resync."}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.ResyncDnsZone("example.com", ctx)
+		if err == nil {
+			t.Fatal("Expected API error, got nil")
+		}
+		if !strings.Contains(err.Error(), "failed to resync zone: Only Secondary") {
+			t.Errorf("Error message mismatch, got %v", err)
+		}
+	})
+}
+
+func TestClient_GetDnsZoneStatus(t *testing.T) {
+	ctx := context.Background()
+
+	mockScenario := test.GetMockScenarioFromFile(t, "../test/mocks/dns_zones_secondary_response.json", http.StatusOK)
+	client, cleanup := GetMockClient(mockScenario)
+	defer cleanup()
+
+	t.Run("zone found", func(t *testing.T) {
+		zone, err := client.GetDnsZoneStatus("secondary.example.", ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if zone.Type != "Secondary" || !zone.SyncFailed || zone.Expiry != "2025-07-01T10:00:00Z" {
+			t.Errorf("Unexpected zone %+v", zone)
+		}
+	})
+
+	t.Run("zone not found", func(t *testing.T) {
+		_, err := client.GetDnsZoneStatus("missing.example", ctx)
+		if err == nil || !strings.Contains(err.Error(), "no such zone was found: missing.example") {
+			t.Errorf("Expected zone not found error, got %v", err)
+		}
+	})
+}
+
+func TestClient_GetClosestDnsZone(t *testing.T) {
+	ctx := context.Background()
+
+	mockScenario := test.GetMockScenarioFromFile(t, "../test/mocks/dns_zones_response.json", http.StatusOK)
+	client, cleanup := GetMockClient(mockScenario)
+	defer cleanup()
+
+	t.Run("zone apex", func(t *testing.T) {
+		zone, err := client.GetClosestDnsZone("Example3.com.", ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if zone != "example3.com" {
+			t.Errorf("Expected zone 'example3.com', got '%s'", zone)
+		}
+	})
+
+	t.Run("subdomain", func(t *testing.T) {
+		zone, err := client.GetClosestDnsZone("1.0.0.127.in-addr.arpa", ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if zone != "127.in-addr.arpa" {
+			t.Errorf("Expected zone '127.in-addr.arpa', got '%s'", zone)
+		}
+	})
+
+	t.Run("zone not found", func(t *testing.T) {
+		_, err := client.GetClosestDnsZone("www.notexample3.com", ctx)
+		if !IsZoneNotFound(err) {
+			t.Errorf("Expected zone not found error, got %v", err)
+		}
+	})
+}
+
+func TestClient_ConvertDnsZone(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("successful conversion", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"ok"}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.ConvertDnsZone("example.com", "Primary", ctx)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	})
+
+	t.Run("api error on conversion", func(t *testing.T) {
+		mockScenario := test.Scenario{
+			ExpectedStatus: http.StatusOK,
+			ExpectedBody:   `{"status":"error", "errorMessage":"Cannot convert the zone to the requested type."}`,
+		}
+		client, cleanup := GetMockClient(mockScenario)
+		defer cleanup()
+
+		err := client.ConvertDnsZone("example.com", "Stub", ctx)
+		if err == nil {
+			t.Fatal("Expected API error, got nil")
+		}
+		if !strings.Contains(err.Error(), "failed to convert zone: Cannot convert") {
+			t.Errorf("Error message mismatch, got %v", err)
+		}
+	})
+}
+
+func TestCanConvertDnsZone(t *testing.T) {
+	cases := []struct {
+		from, to string
+		expected bool
+	}{
+		{"Secondary", "Primary", true},
+		{"Primary", "Forwarder", true},
+		{"Forwarder", "Primary", true},
+		{"Primary", "Secondary", false},
+		{"Stub", "Primary", false},
+	}
+
+	for _, c := range cases {
+		if CanConvertDnsZone(c.from, c.to) != c.expected {
+			t.Errorf("CanConvertDnsZone(%s, %s) expected %t", c.from, c.to, c.expected)
+		}
+	}
+}
+
+func TestIsZoneNotFound(t *testing.T) {
+	if !IsZoneNotFound(errors.New("failed to get zone: No such zone was found: example.com")) {
+		t.Error("Expected a missing zone error to be recognized")
+	}
+	if IsZoneNotFound(errors.New("failed to get zone: The server is busy")) {
+		t.Error("Expected other errors not to be recognized")
+	}
+	if IsZoneNotFound(nil) {
+		t.Error("Expected nil not to be recognized")
+	}
+}
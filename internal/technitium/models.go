@@ -65,6 +65,8 @@ type DnsZoneList struct {
 	SoaSerial    int    `json:"soaSerial"`
 	Expiry       string `json:"expiry,omitempty"`
 	IsExpired    bool   `json:"isExpired,omitempty"`
+	SyncFailed   bool   `json:"syncFailed,omitempty"`
+	NotifyFailed bool   `json:"notifyFailed,omitempty"`
 	LastModified string `json:"lastModified"`
 	Internal     bool   `json:"internal"`
 	Catalog      string `json:"catalog"`
@@ -81,22 +83,25 @@ type DnsZonesResponse struct {
 }
 
 type DnsZone struct {
-	Name                     string   `json:"name"`
-	Type                     string   `json:"type"`
-	Disabled                 bool     `json:"disabled"`
-	DnsSecStatus             string   `json:"dnsSecStatus"`
-	Catalog                  string   `json:"catalog"`
-	NotifyFailed             bool     `json:"notifyFailed"`
-	NotifyFailedFor          []string `json:"notifyFailedFor"`
-	QueryAccess              string   `json:"queryAccess"`
-	QueryAccessNetworkAcl    []string `json:"queryAccessNetworkACL"`
-	ZoneTransfer             string   `json:"zoneTransfer"`
-	ZoneTransferNetworkAcl   []string `json:"zoneTransferNetworkACL"`
-	ZoneTransferTsigKeyNames []string `json:"zoneTransferTsigKeyNames"`
-	Notify                   string   `json:"notify"`
-	NotifyNameServers        []string `json:"notifyNameServers"`
-	Update                   string   `json:"update"`
-	UpdateNetworkAcl         []string `json:"updateNetworkACL"`
+	Name                           string   `json:"name"`
+	Type                           string   `json:"type"`
+	Disabled                       bool     `json:"disabled"`
+	DnsSecStatus                   string   `json:"dnsSecStatus"`
+	Catalog                        string   `json:"catalog"`
+	NotifyFailed                   bool     `json:"notifyFailed"`
+	NotifyFailedFor                []string `json:"notifyFailedFor"`
+	PrimaryNameServerAddresses     []string `json:"primaryNameServerAddresses"`
+	PrimaryZoneTransferProtocol    string   `json:"primaryZoneTransferProtocol"`
+	PrimaryZoneTransferTsigKeyName string   `json:"primaryZoneTransferTsigKeyName"`
+	QueryAccess                    string   `json:"queryAccess"`
+	QueryAccessNetworkAcl          []string `json:"queryAccessNetworkACL"`
+	ZoneTransfer                   string   `json:"zoneTransfer"`
+	ZoneTransferNetworkAcl         []string `json:"zoneTransferNetworkACL"`
+	ZoneTransferTsigKeyNames       []string `json:"zoneTransferTsigKeyNames"`
+	Notify                         string   `json:"notify"`
+	NotifyNameServers              []string `json:"notifyNameServers"`
+	Update                         string   `json:"update"`
+	UpdateNetworkAcl               []string `json:"updateNetworkACL"`
 }
 
 type DnsZoneResponse struct {
@@ -118,6 +123,28 @@ type DnsZoneCreate struct {
 	DnssecValidation           bool     `json:"dnssecValidation"`
 }
 
+// DnsZoneOptions holds the parameters accepted by /api/zones/options/set.
+// Nil slices and empty strings are left out of the request so that the
+// server keeps its current value; pass an empty, non-nil slice to clear a list.
+// Catalog and the primary zone transfer settings are pointers because an empty
+// value clears them on the server.
+type DnsZoneOptions struct {
+	Zone                           string   `json:"zone"`
+	Catalog                        *string  `json:"catalog,omitempty"`
+	PrimaryNameServerAddresses     []string `json:"primaryNameServerAddresses,omitempty"`
+	PrimaryZoneTransferProtocol    *string  `json:"primaryZoneTransferProtocol,omitempty"`
+	PrimaryZoneTransferTsigKeyName *string  `json:"primaryZoneTransferTsigKeyName,omitempty"`
+	QueryAccess                    string   `json:"queryAccess,omitempty"`
+	QueryAccessNetworkAcl          []string `json:"queryAccessNetworkACL,omitempty"`
+	ZoneTransfer                   string   `json:"zoneTransfer,omitempty"`
+	ZoneTransferNetworkAcl         []string `json:"zoneTransferNetworkACL,omitempty"`
+	ZoneTransferTsigKeyNames       []string `json:"zoneTransferTsigKeyNames,omitempty"`
+	Notify                         string   `json:"notify,omitempty"`
+	NotifyNameServers              []string `json:"notifyNameServers,omitempty"`
+	Update                         string   `json:"update,omitempty"`
+	UpdateNetworkAcl               []string `json:"updateNetworkACL,omitempty"`
+}
+
 type DnsZoneCreateResponse struct {
 	Response struct {
 		Domain string `json:"domain"`
@@ -145,67 +172,279 @@ type DnsZoneRecord struct {
 	RecordData   DnsZoneRecordData `json:"rData"`
 }
 
+// DnsZoneRecordData holds the rData properties of every record type. Properties whose JSON type depends on the
+// record type are not decoded by field tags, see DnsZoneRecord.UnmarshalJSON.
 type DnsZoneRecordData struct {
-	PrimaryNameServer   string `json:"primaryNameServer,omitempty"`
-	ResponsiblePerson   string `json:"responsiblePerson,omitempty"`
-	Serial              int64  `json:"serial,omitempty"`
-	Refresh             int64  `json:"refresh"`
-	Retry               int64  `json:"retry"`
-	Expire              int64  `json:"expire"`
-	Minimum             int64  `json:"minimum"`
-	UseSerialDateScheme bool   `json:"useSerialDateScheme"`
-	Protocol            string `json:"protocol"`
-	Forwarder           string `json:"forwarder"`
-	Priority            int64  `json:"priority"`
-	DnssecValidation    bool   `json:"dnssecValidation"`
-	ProxyType           string `json:"proxyType"`
-	IpAddress           string `json:"ipAddress"`
-	NameServer          string `json:"nameServer"`
-	Cname               string `json:"cname"`
+	PrimaryNameServer          string            `json:"primaryNameServer,omitempty"`
+	ResponsiblePerson          string            `json:"responsiblePerson,omitempty"`
+	Serial                     int64             `json:"serial,omitempty"`
+	Refresh                    int64             `json:"refresh"`
+	Retry                      int64             `json:"retry"`
+	Expire                     int64             `json:"expire"`
+	Minimum                    int64             `json:"minimum"`
+	UseSerialDateScheme        bool              `json:"useSerialDateScheme"`
+	Protocol                   string            `json:"-"`
+	Forwarder                  string            `json:"forwarder"`
+	Priority                   int64             `json:"priority"`
+	DnssecValidation           bool              `json:"dnssecValidation"`
+	ProxyType                  string            `json:"proxyType"`
+	ProxyAddress               string            `json:"proxyAddress"`
+	ProxyPort                  int64             `json:"proxyPort"`
+	ProxyUsername              string            `json:"proxyUsername"`
+	ProxyPassword              string            `json:"proxyPassword"`
+	IpAddress                  string            `json:"ipAddress"`
+	NameServer                 string            `json:"nameServer"`
+	Cname                      string            `json:"cname"`
+	PtrName                    string            `json:"ptrName"`
+	Exchange                   string            `json:"exchange"`
+	Preference                 int64             `json:"preference"`
+	Text                       string            `json:"text"`
+	SplitText                  bool              `json:"splitText"`
+	Weight                     int64             `json:"weight"`
+	Port                       int64             `json:"port"`
+	Target                     string            `json:"target"`
+	Order                      int64             `json:"order"`
+	NaptrFlags                 string            `json:"-"`
+	Services                   string            `json:"services"`
+	Regexp                     string            `json:"regexp"`
+	Replacement                string            `json:"replacement"`
+	Dname                      string            `json:"dname"`
+	Aname                      string            `json:"aname"`
+	KeyTag                     int64             `json:"keyTag"`
+	Algorithm                  string            `json:"algorithm"`
+	DigestType                 string            `json:"digestType"`
+	Digest                     string            `json:"digest"`
+	FingerprintType            string            `json:"fingerprintType"`
+	Fingerprint                string            `json:"fingerprint"`
+	CertificateUsage           string            `json:"certificateUsage"`
+	Selector                   string            `json:"selector"`
+	MatchingType               string            `json:"matchingType"`
+	CertificateAssociationData string            `json:"certificateAssociationData"`
+	SvcPriority                int64             `json:"svcPriority"`
+	SvcMode                    string            `json:"svcMode"`
+	TargetName                 string            `json:"targetName"`
+	SvcParams                  map[string]string `json:"svcParams"`
+	AutoIpv4Hint               bool              `json:"autoIpv4Hint"`
+	AutoIpv6Hint               bool              `json:"autoIpv6Hint"`
+	Uri                        string            `json:"uri"`
+	Flags                      int64             `json:"-"`
+	Tag                        string            `json:"tag"`
+	Value                      string            `json:"value"`
+	AppName                    string            `json:"appName"`
+	ClassPath                  string            `json:"classPath"`
+	Data                       string            `json:"data"`
 }
 
 type DnsZoneRecordCreate struct {
-	Domain            string `json:"domain"`
-	Type              string `json:"type"`
-	Zone              string `json:"zone,omitempty"`
-	TTL               int64  `json:"ttl,omitempty"`
-	Comments          string `json:"comments,omitempty"`
-	ExpiryTTL         int64  `json:"expiryTtl,omitempty"`
-	IPAddress         string `json:"ipAddress,omitempty"`
-	Ptr               string `json:"ptr,omitempty"`
-	CreatePtrZone     bool   `json:"createPtrZone,omitempty"`
-	UpdateSvcbHints   bool   `json:"updateSvcbHints,omitempty"`
-	NameServer        string `json:"nameServer,omitempty"`
-	Cname             string `json:"cname,omitempty"`
-	PtrName           string `json:"ptrName,omitempty"`
-	Exchange          string `json:"exchange,omitempty"`
-	Preference        int64  `json:"preference,omitempty"`
-	Text              string `json:"text,omitempty"`
-	SplitText         string `json:"splitText,omitempty"`
-	Protocol          string `json:"protocol,omitempty"`
-	Forwarder         string `json:"forwarder,omitempty"`
-	ForwarderPriority int64  `json:"forwarderPriority,omitempty"`
-	DnssecValidation  bool   `json:"dnssecValidation,omitempty"`
-	ProxyType         string `json:"proxyType,omitempty"`
-	ProxyAddress      string `json:"proxyAddress,omitempty"`
-	ProxyPort         int64  `json:"proxyPort,omitempty"`
-	ProxyUsername     string `json:"proxyUsername,omitempty"`
-	ProxyPassword     string `json:"proxyPassword,omitempty"`
-	AppName           string `json:"appName,omitempty"`
-	ClassPath         string `json:"classPath,omitempty"`
-	RecordData        string `json:"recordData,omitempty"`
+	Domain            string            `json:"domain"`
+	Type              string            `json:"type"`
+	Zone              string            `json:"zone,omitempty"`
+	TTL               int64             `json:"ttl,omitempty"`
+	Comments          string            `json:"comments,omitempty"`
+	ExpiryTTL         int64             `json:"expiryTtl,omitempty"`
+	IPAddress         string            `json:"ipAddress,omitempty"`
+	Ptr               string            `json:"ptr,omitempty"`
+	CreatePtrZone     bool              `json:"createPtrZone,omitempty"`
+	UpdateSvcbHints   bool              `json:"updateSvcbHints,omitempty"`
+	NameServer        string            `json:"nameServer,omitempty"`
+	Cname             string            `json:"cname,omitempty"`
+	Aname             string            `json:"aname,omitempty"`
+	Dname             string            `json:"dname,omitempty"`
+	PtrName           string            `json:"ptrName,omitempty"`
+	Exchange          string            `json:"exchange,omitempty"`
+	Preference        int64             `json:"preference,omitempty"`
+	Text              string            `json:"text,omitempty"`
+	SplitText         string            `json:"splitText,omitempty"`
+	Protocol          string            `json:"protocol,omitempty"`
+	Forwarder         string            `json:"forwarder,omitempty"`
+	ForwarderPriority int64             `json:"forwarderPriority,omitempty"`
+	DnssecValidation  bool              `json:"dnssecValidation,omitempty"`
+	ProxyType         string            `json:"proxyType,omitempty"`
+	ProxyAddress      string            `json:"proxyAddress,omitempty"`
+	ProxyPort         int64             `json:"proxyPort,omitempty"`
+	ProxyUsername     string            `json:"proxyUsername,omitempty"`
+	ProxyPassword     string            `json:"proxyPassword,omitempty"`
+	AppName           string            `json:"appName,omitempty"`
+	ClassPath         string            `json:"classPath,omitempty"`
+	RecordData        string            `json:"recordData,omitempty"`
+	Priority          int64             `json:"priority,omitempty"`
+	Weight            int64             `json:"weight,omitempty"`
+	Port              int64             `json:"port,omitempty"`
+	Target            string            `json:"target,omitempty"`
+	Flags             int64             `json:"flags,omitempty"`
+	Tag               string            `json:"tag,omitempty"`
+	Value             string            `json:"value,omitempty"`
+	SvcPriority       int64             `json:"svcPriority,omitempty"`
+	SvcTargetName     string            `json:"svcTargetName,omitempty"`
+	SvcParams         map[string]string `json:"svcParams,omitempty"`
+
+	KeyTag                         int64  `json:"keyTag,omitempty"`
+	Algorithm                      string `json:"algorithm,omitempty"`
+	DigestType                     string `json:"digestType,omitempty"`
+	Digest                         string `json:"digest,omitempty"`
+	SshfpAlgorithm                 string `json:"sshfpAlgorithm,omitempty"`
+	SshfpFingerprintType           string `json:"sshfpFingerprintType,omitempty"`
+	SshfpFingerprint               string `json:"sshfpFingerprint,omitempty"`
+	TlsaCertificateUsage           string `json:"tlsaCertificateUsage,omitempty"`
+	TlsaSelector                   string `json:"tlsaSelector,omitempty"`
+	TlsaMatchingType               string `json:"tlsaMatchingType,omitempty"`
+	TlsaCertificateAssociationData string `json:"tlsaCertificateAssociationData,omitempty"`
+
+	NaptrOrder       int64  `json:"naptrOrder,omitempty"`
+	NaptrPreference  int64  `json:"naptrPreference,omitempty"`
+	NaptrFlags       string `json:"naptrFlags,omitempty"`
+	NaptrServices    string `json:"naptrServices,omitempty"`
+	NaptrRegexp      string `json:"naptrRegexp,omitempty"`
+	NaptrReplacement string `json:"naptrReplacement,omitempty"`
+	UriPriority      int64  `json:"uriPriority,omitempty"`
+	UriWeight        int64  `json:"uriWeight,omitempty"`
+	Uri              string `json:"uri,omitempty"`
 }
 
 type DnsZoneRecordUpdate struct {
 	DnsZoneRecordCreate
-	Disable       bool   `json:"disable,omitempty"`
-	NewDomain     string `json:"newDomain,omitempty"`
-	NewIPAddress  string `json:"newIPAddress,omitempty"`
-	NewNameServer string `json:"newNameServer,omitempty"`
-	NewPtrName    string `json:"newPtrName,omitempty"`
-	NewExchange   string `json:"newExchange,omitempty"`
-	NewPreference int64  `json:"newPreference,omitempty"`
-	NewText       string `json:"newText,omitempty"`
-	NewSplitText  string `json:"newSplitText,omitempty"`
-	NewForwarder  string `json:"newForwarder,omitempty"`
+	Disable          bool              `json:"disable,omitempty"`
+	NewDomain        string            `json:"newDomain,omitempty"`
+	NewIPAddress     string            `json:"newIPAddress,omitempty"`
+	NewNameServer    string            `json:"newNameServer,omitempty"`
+	NewPtrName       string            `json:"newPtrName,omitempty"`
+	NewAname         string            `json:"newAname,omitempty"`
+	NewExchange      string            `json:"newExchange,omitempty"`
+	NewPreference    int64             `json:"newPreference,omitempty"`
+	NewText          string            `json:"newText,omitempty"`
+	NewSplitText     string            `json:"newSplitText,omitempty"`
+	NewForwarder     string            `json:"newForwarder,omitempty"`
+	NewPriority      int64             `json:"newPriority,omitempty"`
+	NewWeight        int64             `json:"newWeight,omitempty"`
+	NewPort          int64             `json:"newPort,omitempty"`
+	NewTarget        string            `json:"newTarget,omitempty"`
+	NewFlags         int64             `json:"newFlags,omitempty"`
+	NewTag           string            `json:"newTag,omitempty"`
+	NewValue         string            `json:"newValue,omitempty"`
+	NewSvcPriority   int64             `json:"newSvcPriority,omitempty"`
+	NewSvcTargetName string            `json:"newSvcTargetName,omitempty"`
+	NewSvcParams     map[string]string `json:"newSvcParams,omitempty"`
+
+	NewKeyTag                         int64  `json:"newKeyTag,omitempty"`
+	NewAlgorithm                      string `json:"newAlgorithm,omitempty"`
+	NewDigestType                     string `json:"newDigestType,omitempty"`
+	NewDigest                         string `json:"newDigest,omitempty"`
+	NewSshfpAlgorithm                 string `json:"newSshfpAlgorithm,omitempty"`
+	NewSshfpFingerprintType           string `json:"newSshfpFingerprintType,omitempty"`
+	NewSshfpFingerprint               string `json:"newSshfpFingerprint,omitempty"`
+	NewTlsaCertificateUsage           string `json:"newTlsaCertificateUsage,omitempty"`
+	NewTlsaSelector                   string `json:"newTlsaSelector,omitempty"`
+	NewTlsaMatchingType               string `json:"newTlsaMatchingType,omitempty"`
+	NewTlsaCertificateAssociationData string `json:"newTlsaCertificateAssociationData,omitempty"`
+
+	NewNaptrOrder       int64  `json:"newNaptrOrder,omitempty"`
+	NewNaptrPreference  int64  `json:"newNaptrPreference,omitempty"`
+	NewNaptrFlags       string `json:"newNaptrFlags,omitempty"`
+	NewNaptrServices    string `json:"newNaptrServices,omitempty"`
+	NewNaptrRegexp      string `json:"newNaptrRegexp,omitempty"`
+	NewNaptrReplacement string `json:"newNaptrReplacement,omitempty"`
+	NewUriPriority      int64  `json:"newUriPriority,omitempty"`
+	NewUriWeight        int64  `json:"newUriWeight,omitempty"`
+	NewUri              string `json:"newUri,omitempty"`
+}
+
+type DnsZoneDnssecSign struct {
+	Zone            string `json:"zone"`
+	Algorithm       string `json:"algorithm"`
+	HashAlgorithm   string `json:"hashAlgorithm,omitempty"`
+	KskKeySize      int64  `json:"kskKeySize,omitempty"`
+	ZskKeySize      int64  `json:"zskKeySize,omitempty"`
+	Curve           string `json:"curve,omitempty"`
+	DnsKeyTTL       int64  `json:"dnsKeyTtl,omitempty"`
+	ZskRolloverDays int64  `json:"zskRolloverDays,omitempty"`
+	NxProof         string `json:"nxProof,omitempty"`
+	Iterations      int64  `json:"iterations,omitempty"`
+	SaltLength      int64  `json:"saltLength,omitempty"`
+}
+
+type DnssecPrivateKey struct {
+	KeyTag         int64  `json:"keyTag"`
+	KeyType        string `json:"keyType"`
+	Algorithm      string `json:"algorithm"`
+	State          string `json:"state"`
+	StateChangedOn string `json:"stateChangedOn"`
+	StateReadyBy   string `json:"stateReadyBy"`
+	IsRetiring     bool   `json:"isRetiring"`
+	RolloverDays   int64  `json:"rolloverDays"`
+}
+
+type DnsZoneDnssecProperties struct {
+	Name              string             `json:"name"`
+	Type              string             `json:"type"`
+	Internal          bool               `json:"internal"`
+	Disabled          bool               `json:"disabled"`
+	DnssecStatus      string             `json:"dnssecStatus"`
+	Nsec3Iterations   int64              `json:"nsec3Iterations"`
+	Nsec3SaltLength   int64              `json:"nsec3SaltLength"`
+	DnsKeyTTL         int64              `json:"dnsKeyTtl"`
+	DnssecPrivateKeys []DnssecPrivateKey `json:"dnssecPrivateKeys"`
+}
+
+type DnsZoneDnssecPropertiesResponse struct {
+	Response DnsZoneDnssecProperties `json:"response"`
+	BaseResponse
+}
+
+type DsDigest struct {
+	DigestType string `json:"digestType"`
+	Digest     string `json:"digest"`
+}
+
+type DsRecord struct {
+	KeyTag             int64      `json:"keyTag"`
+	DnsKeyState        string     `json:"dnsKeyState"`
+	DnsKeyStateReadyBy string     `json:"dnsKeyStateReadyBy"`
+	Algorithm          string     `json:"algorithm"`
+	PublicKey          string     `json:"publicKey"`
+	Digests            []DsDigest `json:"digests"`
+}
+
+type DnsZoneDsRecordsResponse struct {
+	Response struct {
+		Name         string     `json:"name"`
+		Type         string     `json:"type"`
+		DnssecStatus string     `json:"dnssecStatus"`
+		DsRecords    []DsRecord `json:"dsRecords"`
+	} `json:"response"`
+	BaseResponse
+}
+
+type DnssecPrivateKeyCreate struct {
+	Zone          string `json:"zone"`
+	KeyType       string `json:"keyType"`
+	RolloverDays  int64  `json:"rolloverDays,omitempty"`
+	Algorithm     string `json:"algorithm"`
+	HashAlgorithm string `json:"hashAlgorithm,omitempty"`
+	KeySize       int64  `json:"keySize,omitempty"`
+	Curve         string `json:"curve,omitempty"`
+}
+
+type DnsAppsResponse struct {
+	Response struct {
+		Apps []DnsApp `json:"apps"`
+	} `json:"response"`
+	BaseResponse
+}
+
+type DnsApp struct {
+	Name    string          `json:"name"`
+	Version string          `json:"version"`
+	DnsApps []DnsAppHandler `json:"dnsApps"`
+}
+
+type DnsAppHandler struct {
+	ClassPath                     string `json:"classPath"`
+	Description                   string `json:"description"`
+	IsAppRecordRequestHandler     bool   `json:"isAppRecordRequestHandler"`
+	RecordDataTemplate            string `json:"recordDataTemplate"`
+	IsRequestController           bool   `json:"isRequestController"`
+	IsAuthoritativeRequestHandler bool   `json:"isAuthoritativeRequestHandler"`
+	IsRequestBlockingHandler      bool   `json:"isRequestBlockingHandler"`
+	IsQueryLogger                 bool   `json:"isQueryLogger"`
+	IsPostProcessor               bool   `json:"isPostProcessor"`
 }
@@ -0,0 +1,435 @@
+package technitium
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// RecordValue returns the presentation form of the record data, which identifies the record among the
+// records with the same name and type. Domain names are lowercase and have no trailing dot. It is empty for
+// types without a presentation form, of which a name can only have one record.
+func RecordValue(r DnsZoneRecord) string {
+	return FormatRecordValue(r.Type, r.RecordData)
+}
+
+// FormatRecordValue returns the presentation form of the record data of the given type.
+func FormatRecordValue(recordType string, d DnsZoneRecordData) string {
+	switch recordType {
+	case "A", "AAAA":
+		if ip := net.ParseIP(d.IpAddress); ip != nil {
+			return ip.String()
+		}
+		return d.IpAddress
+	case "NS":
+		return normalizeDomainName(d.NameServer)
+	case "CNAME":
+		return normalizeDomainName(d.Cname)
+	case "ANAME":
+		return normalizeDomainName(d.Aname)
+	case "DNAME":
+		return normalizeDomainName(d.Dname)
+	case "PTR":
+		return normalizeDomainName(d.PtrName)
+	case "MX":
+		return fmt.Sprintf("%d %s", d.Preference, normalizeDomainName(d.Exchange))
+	case "TXT":
+		return d.Text
+	case "FWD":
+		return fmt.Sprintf("%s %s", strings.ToUpper(d.Protocol), strings.ToLower(d.Forwarder))
+	case "SRV":
+		return fmt.Sprintf("%d %d %d %s", d.Priority, d.Weight, d.Port, normalizeDomainName(d.Target))
+	case "CAA":
+		return fmt.Sprintf("%d %s %q", d.Flags, strings.ToLower(d.Tag), d.Value)
+	case "SVCB", "HTTPS":
+		return formatSvcbValue(d)
+	case "DS":
+		return fmt.Sprintf("%d %s %s %s", d.KeyTag, CanonicalDnssecAlgorithm(d.Algorithm), CanonicalDsDigestType(d.DigestType), NormalizeHex(d.Digest))
+	case "SSHFP":
+		return fmt.Sprintf("%s %s %s", strings.ToUpper(d.Algorithm), strings.ToUpper(d.FingerprintType), NormalizeHex(d.Fingerprint))
+	case "TLSA":
+		return fmt.Sprintf("%s %s %s %s", strings.ToUpper(d.CertificateUsage), strings.ToUpper(d.Selector), strings.ToUpper(d.MatchingType),
+			NormalizeHex(d.CertificateAssociationData))
+	case "NAPTR":
+		replacement := normalizeDomainName(d.Replacement)
+		if replacement == "" {
+			replacement = "."
+		}
+		return fmt.Sprintf("%d %d \"%s\" \"%s\" \"%s\" %s", d.Order, d.Preference, strings.ToUpper(d.NaptrFlags), d.Services, d.Regexp, replacement)
+	case "URI":
+		return fmt.Sprintf("%d %d \"%s\"", d.Priority, d.Weight, d.Uri)
+	}
+	return ""
+}
+
+// ParseRecordValue parses the presentation form of the record data of the given type.
+func ParseRecordValue(recordType string, value string) (DnsZoneRecordData, error) {
+
+	var d DnsZoneRecordData
+	fields := strings.Fields(value)
+
+	switch recordType {
+	case "A", "AAAA":
+		ip := net.ParseIP(strings.TrimSpace(value))
+		if ip == nil || (recordType == "A") != (ip.To4() != nil) {
+			return d, fmt.Errorf("invalid %s record value %q", recordType, value)
+		}
+		d.IpAddress = ip.String()
+	case "NS", "CNAME", "ANAME", "DNAME", "PTR":
+		if len(fields) != 1 {
+			return d, fmt.Errorf("invalid %s record value %q, expected a domain name", recordType, value)
+		}
+		name := normalizeDomainName(fields[0])
+		switch recordType {
+		case "NS":
+			d.NameServer = name
+		case "CNAME":
+			d.Cname = name
+		case "ANAME":
+			d.Aname = name
+		case "DNAME":
+			d.Dname = name
+		case "PTR":
+			d.PtrName = name
+		}
+	case "MX":
+		if len(fields) != 2 {
+			return d, fmt.Errorf("invalid MX record value %q, expected \"<preference> <exchange>\"", value)
+		}
+		preference, err := strconv.ParseUint(fields[0], 10, 16)
+		if err != nil {
+			return d, fmt.Errorf("invalid MX record preference %q", fields[0])
+		}
+		d.Preference = int64(preference)
+		d.Exchange = normalizeDomainName(fields[1])
+	case "TXT":
+		d.Text = value
+	case "FWD":
+		if len(fields) != 2 {
+			return d, fmt.Errorf("invalid FWD record value %q, expected \"<protocol> <forwarder>\"", value)
+		}
+		d.Protocol = fields[0]
+		d.Forwarder = strings.ToLower(fields[1])
+	case "SRV":
+		if len(fields) != 4 {
+			return d, fmt.Errorf("invalid SRV record value %q, expected \"<priority> <weight> <port> <target>\"", value)
+		}
+		numbers, err := parseUint16Fields(fields[:3])
+		if err != nil {
+			return d, fmt.Errorf("invalid SRV record value %q: %w", value, err)
+		}
+		d.Priority, d.Weight, d.Port = numbers[0], numbers[1], numbers[2]
+		d.Target = normalizeDomainName(fields[3])
+	case "CAA":
+		if len(fields) < 3 {
+			return d, fmt.Errorf("invalid CAA record value %q, expected \"<flags> <tag> <value>\"", value)
+		}
+		flags, err := strconv.ParseUint(fields[0], 10, 8)
+		if err != nil {
+			return d, fmt.Errorf("invalid CAA record flags %q", fields[0])
+		}
+		d.Flags = int64(flags)
+		d.Tag = strings.ToLower(fields[1])
+		d.Value = strings.Trim(strings.Join(fields[2:], " "), "\"")
+	case "SVCB", "HTTPS":
+		if len(fields) < 2 {
+			return d, fmt.Errorf("invalid %s record value %q, expected \"<priority> <target name> [<key>=<value> ...]\"", recordType, value)
+		}
+		priority, err := strconv.ParseUint(fields[0], 10, 16)
+		if err != nil {
+			return d, fmt.Errorf("invalid %s record priority %q", recordType, fields[0])
+		}
+		d.SvcPriority = int64(priority)
+		d.TargetName = normalizeDomainName(fields[1])
+		if d.TargetName == "" {
+			d.TargetName = "."
+		}
+		for _, param := range fields[2:] {
+			key, paramValue, _ := strings.Cut(param, "=")
+			if d.SvcParams == nil {
+				d.SvcParams = make(map[string]string)
+			}
+			d.SvcParams[strings.ToLower(key)] = strings.Trim(paramValue, "\"")
+		}
+	case "DS":
+		if len(fields) < 4 {
+			return d, fmt.Errorf("invalid DS record value %q, expected \"<key tag> <algorithm> <digest type> <digest>\"", value)
+		}
+		keyTag, err := strconv.ParseUint(fields[0], 10, 16)
+		if err != nil {
+			return d, fmt.Errorf("invalid DS record key tag %q", fields[0])
+		}
+		d.KeyTag = int64(keyTag)
+		d.Algorithm = CanonicalDnssecAlgorithm(fields[1])
+		d.DigestType = CanonicalDsDigestType(fields[2])
+		d.Digest = NormalizeHex(strings.Join(fields[3:], ""))
+	case "SSHFP":
+		if len(fields) < 3 {
+			return d, fmt.Errorf("invalid SSHFP record value %q, expected \"<algorithm> <fingerprint type> <fingerprint>\"", value)
+		}
+		d.Algorithm = strings.ToUpper(fields[0])
+		d.FingerprintType = strings.ToUpper(fields[1])
+		d.Fingerprint = NormalizeHex(strings.Join(fields[2:], ""))
+	case "TLSA":
+		if len(fields) < 4 {
+			return d, fmt.Errorf("invalid TLSA record value %q, expected \"<certificate usage> <selector> <matching type> <data>\"", value)
+		}
+		d.CertificateUsage = strings.ToUpper(fields[0])
+		d.Selector = strings.ToUpper(fields[1])
+		d.MatchingType = strings.ToUpper(fields[2])
+		d.CertificateAssociationData = NormalizeHex(strings.Join(fields[3:], ""))
+	case "NAPTR":
+		quoted, err := splitQuotedFields(value)
+		if err != nil || len(quoted) != 6 {
+			return d, fmt.Errorf("invalid NAPTR record value %q, expected \"<order> <preference> <flags> <services> <regexp> <replacement>\"", value)
+		}
+		numbers, err := parseUint16Fields(quoted[:2])
+		if err != nil {
+			return d, fmt.Errorf("invalid NAPTR record value %q: %w", value, err)
+		}
+		d.Order, d.Preference = numbers[0], numbers[1]
+		d.NaptrFlags = strings.ToUpper(quoted[2])
+		d.Services = quoted[3]
+		d.Regexp = quoted[4]
+		d.Replacement = normalizeDomainName(quoted[5])
+	case "URI":
+		quoted, err := splitQuotedFields(value)
+		if err != nil || len(quoted) != 3 {
+			return d, fmt.Errorf("invalid URI record value %q, expected \"<priority> <weight> <uri>\"", value)
+		}
+		numbers, err := parseUint16Fields(quoted[:2])
+		if err != nil {
+			return d, fmt.Errorf("invalid URI record value %q: %w", value, err)
+		}
+		d.Priority, d.Weight = numbers[0], numbers[1]
+		d.Uri = quoted[2]
+	default:
+		return d, fmt.Errorf("record values of type %s are not supported", recordType)
+	}
+
+	return d, nil
+}
+
+// NormalizeRecordValue returns the value in the form returned by RecordValue for the same record data.
+func NormalizeRecordValue(recordType string, value string) (string, error) {
+	d, err := ParseRecordValue(recordType, value)
+	if err != nil {
+		return "", err
+	}
+	return FormatRecordValue(recordType, d), nil
+}
+
+// NewDnsZoneRecordCreate returns the parameters to add a record with the given record data.
+func NewDnsZoneRecordCreate(zone string, domain string, recordType string, ttl int64, d DnsZoneRecordData) DnsZoneRecordCreate {
+	r := DnsZoneRecordCreate{
+		Domain:     domain,
+		Type:       recordType,
+		Zone:       zone,
+		TTL:        ttl,
+		IPAddress:  d.IpAddress,
+		NameServer: d.NameServer,
+		Cname:      d.Cname,
+		Aname:      d.Aname,
+		Dname:      d.Dname,
+		PtrName:    d.PtrName,
+		Exchange:   d.Exchange,
+		Preference: d.Preference,
+		Text:       d.Text,
+		Protocol:   d.Protocol,
+		Forwarder:  d.Forwarder,
+		Priority:   d.Priority,
+		Weight:     d.Weight,
+		Port:       d.Port,
+		Target:     d.Target,
+		Flags:      d.Flags,
+		Tag:        d.Tag,
+		Value:      d.Value,
+
+		SvcPriority:   d.SvcPriority,
+		SvcTargetName: d.TargetName,
+		SvcParams:     d.SvcParams,
+
+		KeyTag:                         d.KeyTag,
+		DigestType:                     d.DigestType,
+		Digest:                         d.Digest,
+		TlsaCertificateUsage:           d.CertificateUsage,
+		TlsaSelector:                   d.Selector,
+		TlsaMatchingType:               d.MatchingType,
+		TlsaCertificateAssociationData: d.CertificateAssociationData,
+	}
+
+	// Some properties of the record data are shared by types that take different parameters
+	switch recordType {
+	case "SSHFP":
+		r.SshfpAlgorithm = d.Algorithm
+		r.SshfpFingerprintType = d.FingerprintType
+		r.SshfpFingerprint = d.Fingerprint
+	case "NAPTR":
+		r.NaptrOrder = d.Order
+		r.NaptrPreference = d.Preference
+		r.NaptrFlags = d.NaptrFlags
+		r.NaptrServices = d.Services
+		r.NaptrRegexp = d.Regexp
+		r.NaptrReplacement = d.Replacement
+	case "URI":
+		r.UriPriority = d.Priority
+		r.UriWeight = d.Weight
+		r.Uri = d.Uri
+	default:
+		r.Algorithm = d.Algorithm
+	}
+
+	return r
+}
+
+// NewDnsZoneRecordUpdate returns the parameters to update a record without changing its record data.
+func NewDnsZoneRecordUpdate(r DnsZoneRecordCreate) DnsZoneRecordUpdate {
+	return DnsZoneRecordUpdate{
+		DnsZoneRecordCreate: r,
+		NewIPAddress:        r.IPAddress,
+		NewNameServer:       r.NameServer,
+		NewPtrName:          r.PtrName,
+		NewAname:            r.Aname,
+		NewExchange:         r.Exchange,
+		NewPreference:       r.Preference,
+		NewText:             r.Text,
+		NewSplitText:        r.SplitText,
+		NewForwarder:        r.Forwarder,
+		NewPriority:         r.Priority,
+		NewWeight:           r.Weight,
+		NewPort:             r.Port,
+		NewTarget:           r.Target,
+		NewFlags:            r.Flags,
+		NewTag:              r.Tag,
+		NewValue:            r.Value,
+		NewSvcPriority:      r.SvcPriority,
+		NewSvcTargetName:    r.SvcTargetName,
+		NewSvcParams:        r.SvcParams,
+
+		NewKeyTag:                         r.KeyTag,
+		NewAlgorithm:                      r.Algorithm,
+		NewDigestType:                     r.DigestType,
+		NewDigest:                         r.Digest,
+		NewSshfpAlgorithm:                 r.SshfpAlgorithm,
+		NewSshfpFingerprintType:           r.SshfpFingerprintType,
+		NewSshfpFingerprint:               r.SshfpFingerprint,
+		NewTlsaCertificateUsage:           r.TlsaCertificateUsage,
+		NewTlsaSelector:                   r.TlsaSelector,
+		NewTlsaMatchingType:               r.TlsaMatchingType,
+		NewTlsaCertificateAssociationData: r.TlsaCertificateAssociationData,
+
+		NewNaptrOrder:       r.NaptrOrder,
+		NewNaptrPreference:  r.NaptrPreference,
+		NewNaptrFlags:       r.NaptrFlags,
+		NewNaptrServices:    r.NaptrServices,
+		NewNaptrRegexp:      r.NaptrRegexp,
+		NewNaptrReplacement: r.NaptrReplacement,
+		NewUriPriority:      r.UriPriority,
+		NewUriWeight:        r.UriWeight,
+		NewUri:              r.Uri,
+	}
+}
+
+// formatSvcbValue returns the priority, the target name, which is "." for the owner name, and the parameters sorted by key.
+func formatSvcbValue(d DnsZoneRecordData) string {
+	target := normalizeDomainName(d.TargetName)
+	if target == "" {
+		target = "."
+	}
+
+	keys := make([]string, 0, len(d.SvcParams))
+	for key := range d.SvcParams {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	value := fmt.Sprintf("%d %s", d.SvcPriority, target)
+	for _, key := range keys {
+		value += fmt.Sprintf(" %s=%s", key, d.SvcParams[key])
+	}
+	return value
+}
+
+// splitQuotedFields splits a value on spaces, keeping the spaces inside double quotes and removing the quotes.
+func splitQuotedFields(value string) ([]string, error) {
+	var fields []string
+	var field strings.Builder
+	inField, inQuotes := false, false
+
+	for _, ch := range value {
+		switch {
+		case ch == '"':
+			inQuotes = !inQuotes
+			inField = true
+		case !inQuotes && (ch == ' ' || ch == '\t'):
+			if inField {
+				fields = append(fields, field.String())
+				field.Reset()
+				inField = false
+			}
+		default:
+			field.WriteRune(ch)
+			inField = true
+		}
+	}
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quoted string in %q", value)
+	}
+	if inField {
+		fields = append(fields, field.String())
+	}
+
+	return fields, nil
+}
+
+func parseUint16Fields(fields []string) ([]int64, error) {
+	numbers := make([]int64, 0, len(fields))
+	for _, field := range fields {
+		n, err := strconv.ParseUint(field, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a number between 0 and 65535", field)
+		}
+		numbers = append(numbers, int64(n))
+	}
+	return numbers, nil
+}
+
+// CanonicalDnssecAlgorithm returns the mnemonic of DnssecAlgorithmNumbers for an algorithm given by its number or by
+// its mnemonic in any case and with underscores instead of hyphens. Unknown algorithms are returned in uppercase.
+func CanonicalDnssecAlgorithm(algorithm string) string {
+	return canonicalMnemonic(algorithm, DnssecAlgorithmNumbers)
+}
+
+// CanonicalDsDigestType returns the mnemonic of DsDigestTypeNumbers for a digest type given by its number or by its
+// mnemonic in any case. Unknown digest types are returned in uppercase.
+func CanonicalDsDigestType(digestType string) string {
+	return canonicalMnemonic(digestType, DsDigestTypeNumbers)
+}
+
+func canonicalMnemonic(value string, numbers map[string]int64) string {
+	name := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(value), "_", "-"))
+	if _, ok := numbers[name]; ok {
+		return name
+	}
+
+	if n, err := strconv.ParseInt(name, 10, 64); err == nil {
+		for mnemonic, number := range numbers {
+			if number == n {
+				return mnemonic
+			}
+		}
+	}
+
+	return name
+}
+
+// NormalizeHex returns hexadecimal data in lowercase without the spaces allowed in zone files.
+func NormalizeHex(data string) string {
+	return strings.ToLower(strings.Join(strings.Fields(data), ""))
+}
+
+func normalizeDomainName(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".")
+}
@@ -0,0 +1,105 @@
+package technitium
+
+import (
+	"testing"
+)
+
+func TestNormalizeRecordValue(t *testing.T) {
+
+	t.Run("valid values", func(t *testing.T) {
+		tests := []struct {
+			recordType string
+			value      string
+			expected   string
+		}{
+			{"A", " 192.0.2.1 ", "192.0.2.1"},
+			{"AAAA", "2001:DB8:0::1", "2001:db8::1"},
+			{"NS", "NS1.Example.com.", "ns1.example.com"},
+			{"CNAME", "www.example.com", "www.example.com"},
+			{"PTR", "host.example.com.", "host.example.com"},
+			{"ANAME", "LB.Example.net.", "lb.example.net"},
+			{"DNAME", "example.net", "example.net"},
+			{"MX", "10  Mail.Example.com.", "10 mail.example.com"},
+			{"TXT", "v=spf1 -all", "v=spf1 -all"},
+			{"FWD", "Https https://Cloudflare-DNS.com/dns-query", "HTTPS https://cloudflare-dns.com/dns-query"},
+			{"FWD", "udp 192.0.2.53", "UDP 192.0.2.53"},
+			{"SRV", "0 5 5060 SIP.example.com.", "0 5 5060 sip.example.com"},
+			{"CAA", `0 ISSUE "letsencrypt.org"`, `0 issue "letsencrypt.org"`},
+			{"CAA", "128 iodef mailto:security@example.com", `128 iodef "mailto:security@example.com"`},
+			{"HTTPS", `1 . port=443 alpn="h2,h3"`, "1 . alpn=h2,h3 port=443"},
+			{"SVCB", "0 Svc.Example.com.", "0 svc.example.com"},
+			{"DS", "12345 ecdsap256sha256 sha256 ABCDEF01 23456789", "12345 ECDSAP256SHA256 SHA256 abcdef0123456789"},
+			{"DS", "12345 13 2 abcdef", "12345 ECDSAP256SHA256 SHA256 abcdef"},
+			{"DS", "12345 RSASHA1_NSEC3_SHA1 SHA1 abcdef", "12345 RSASHA1-NSEC3-SHA1 SHA1 abcdef"},
+			{"SSHFP", "Ed25519 SHA256 ABCDEF", "ED25519 SHA256 abcdef"},
+			{"TLSA", "DANE-EE SPKI SHA2-256 ABCDEF", "DANE-EE SPKI SHA2-256 abcdef"},
+			{"NAPTR", `100 10 "u" "E2U+sip" "!^.*$!sip:info@example.com!" .`, `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!" .`},
+			{"NAPTR", `100 20 "S" "SIP+D2U" "" _sip._udp.Example.com.`, `100 20 "S" "SIP+D2U" "" _sip._udp.example.com`},
+			{"URI", `10 1 "https://www.example.com/path"`, `10 1 "https://www.example.com/path"`},
+		}
+
+		for _, tt := range tests {
+			value, err := NormalizeRecordValue(tt.recordType, tt.value)
+			if err != nil {
+				t.Errorf("%s %q: expected no error, got %v", tt.recordType, tt.value, err)
+				continue
+			}
+			if value != tt.expected {
+				t.Errorf("%s %q: expected %q, got %q", tt.recordType, tt.value, tt.expected, value)
+			}
+		}
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		tests := []struct {
+			recordType string
+			value      string
+		}{
+			{"A", "2001:db8::1"},
+			{"AAAA", "192.0.2.1"},
+			{"NS", "ns1 ns2"},
+			{"MX", "mail.example.com"},
+			{"MX", "70000 mail.example.com"},
+			{"SRV", "10 60 sip.example.com"},
+			{"SRV", "10 60 port sip.example.com"},
+			{"CAA", "256 issue letsencrypt.org"},
+			{"HTTPS", "1"},
+			{"DS", "123456 ECDSAP256SHA256 SHA256 abcdef"},
+			{"TLSA", "DANE-EE SPKI SHA2-256"},
+			{"NAPTR", `100 10 "U" "E2U+sip" "!^.*$!sip:info@example.com!`},
+			{"URI", `10 "https://www.example.com/"`},
+			{"UNKNOWN", "value"},
+		}
+
+		for _, tt := range tests {
+			if _, err := NormalizeRecordValue(tt.recordType, tt.value); err == nil {
+				t.Errorf("%s %q: expected an error", tt.recordType, tt.value)
+			}
+		}
+	})
+
+	t.Run("record value matches parsed value", func(t *testing.T) {
+		record := DnsZoneRecord{Name: "example.com", Type: "MX", RecordData: DnsZoneRecordData{Preference: 10, Exchange: "mail.example.com"}}
+
+		value, err := NormalizeRecordValue("MX", "10 MAIL.example.com.")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if RecordValue(record) != value {
+			t.Errorf("Expected %q, got %q", value, RecordValue(record))
+		}
+	})
+}
+
+func TestFormatSvcParams(t *testing.T) {
+	params := map[string]string{"port": "443", "alpn": "h2,h3", "ipv4hint": "192.0.2.1,192.0.2.2"}
+
+	expected := "alpn|h2,h3|ipv4hint|192.0.2.1,192.0.2.2|port|443"
+	if got := FormatSvcParams(params); got != expected {
+		t.Errorf("Expected %q, got %q", expected, got)
+	}
+
+	if got := FormatSvcParams(nil); got != "" {
+		t.Errorf("Expected no parameters, got %q", got)
+	}
+}
@@ -0,0 +1,343 @@
+package technitium
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ZoneFileRecord is a resource record read from an RFC 1035 zone file.
+type ZoneFileRecord struct {
+	Name  string
+	TTL   int64
+	Type  string
+	RData []string
+}
+
+// zoneFileDomainNameFields lists the RDATA fields holding domain names, which are qualified and compared case-insensitively.
+var zoneFileDomainNameFields = map[string][]int{
+	"NS":    {0},
+	"CNAME": {0},
+	"DNAME": {0},
+	"ANAME": {0},
+	"PTR":   {0},
+	"SOA":   {0, 1},
+	"MX":    {1},
+	"SRV":   {3},
+	"NAPTR": {5},
+	"SVCB":  {1},
+	"HTTPS": {1},
+}
+
+var zoneFileClasses = map[string]bool{
+	"IN": true,
+	"CH": true,
+	"CS": true,
+	"HS": true,
+}
+
+// Key identifies the record by owner name, type and normalized RDATA, ignoring the TTL.
+func (r ZoneFileRecord) Key() string {
+	return strings.ToLower(r.Name) + " " + r.Type + " " + r.Value()
+}
+
+// Value returns the RDATA in the form of RecordValue for the types it supports, so that records only differing in
+// formatting, such as the notation of an IPv6 address, the case of hexadecimal data or the splitting of a TXT
+// record into character strings, have the same value. Other types keep their RDATA tokens.
+func (r ZoneFileRecord) Value() string {
+
+	value := strings.Join(r.RData, " ")
+	if r.Type == "TXT" {
+		var text strings.Builder
+		for _, token := range r.RData {
+			text.WriteString(unquoteCharacterString(token))
+		}
+		value = text.String()
+	}
+
+	normalized, err := NormalizeRecordValue(r.Type, value)
+	if err != nil {
+		return value
+	}
+
+	return normalized
+}
+
+// ParseZoneFile parses the resource records of a zone file. Relative names are qualified with the
+// origin, which is the zone name unless changed by an $ORIGIN directive.
+func ParseZoneFile(content string, zone string) ([]ZoneFileRecord, error) {
+
+	origin := fqdn(zone)
+	var defaultTTL int64
+	var lastName string
+	var lastTTL int64
+
+	lines, err := zoneFileLogicalLines(content)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]ZoneFileRecord, 0, len(lines))
+	for _, line := range lines {
+		tokens := line.tokens
+		if len(tokens) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) < 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN requires a domain name", line.number)
+			}
+			origin = qualifyName(tokens[1], origin)
+			continue
+		case "$TTL":
+			if len(tokens) < 2 {
+				return nil, fmt.Errorf("line %d: $TTL requires a value", line.number)
+			}
+			defaultTTL, err = parseZoneFileTTL(tokens[1])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line.number, err)
+			}
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fmt.Errorf("line %d: %s is not supported", line.number, tokens[0])
+		}
+
+		record := ZoneFileRecord{TTL: -1}
+		if line.continuesOwner {
+			if lastName == "" {
+				return nil, fmt.Errorf("line %d: missing owner name", line.number)
+			}
+			record.Name = lastName
+		} else {
+			record.Name = qualifyName(tokens[0], origin)
+			tokens = tokens[1:]
+		}
+
+		// The TTL and class may appear in either order before the type
+		for len(tokens) > 0 {
+			if zoneFileClasses[strings.ToUpper(tokens[0])] {
+				tokens = tokens[1:]
+				continue
+			}
+			if ttl, err := parseZoneFileTTL(tokens[0]); err == nil && record.TTL < 0 {
+				record.TTL = ttl
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: missing record type", line.number)
+		}
+
+		record.Type = strings.ToUpper(tokens[0])
+		record.RData = append([]string{}, tokens[1:]...)
+
+		if record.TTL < 0 {
+			if defaultTTL > 0 {
+				record.TTL = defaultTTL
+			} else {
+				record.TTL = lastTTL
+			}
+		}
+
+		for _, i := range zoneFileDomainNameFields[record.Type] {
+			if i < len(record.RData) {
+				record.RData[i] = strings.ToLower(qualifyName(record.RData[i], origin))
+			}
+		}
+
+		lastName = record.Name
+		lastTTL = record.TTL
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+type zoneFileLine struct {
+	number         int
+	continuesOwner bool
+	tokens         []string
+}
+
+// zoneFileLogicalLines splits the content into tokens, removing comments and joining lines inside parentheses.
+func zoneFileLogicalLines(content string) ([]zoneFileLine, error) {
+
+	var lines []zoneFileLine
+	var current *zoneFileLine
+	var token strings.Builder
+	inToken, inQuotes, escaped := false, false, false
+	depth, number := 0, 1
+	startOfLine := true
+
+	flushToken := func() {
+		if inToken {
+			current.tokens = append(current.tokens, token.String())
+			token.Reset()
+			inToken = false
+		}
+	}
+
+	for i := 0; i < len(content); i++ {
+		ch := content[i]
+
+		if current == nil {
+			current = &zoneFileLine{number: number}
+		}
+
+		if startOfLine {
+			current.continuesOwner = ch == ' ' || ch == '\t'
+			startOfLine = false
+		}
+
+		switch {
+		case escaped:
+			token.WriteByte(ch)
+			escaped = false
+		case ch == '\\':
+			token.WriteByte(ch)
+			inToken = true
+			escaped = true
+		case inQuotes:
+			token.WriteByte(ch)
+			if ch == '"' {
+				inQuotes = false
+			}
+			if ch == '\n' {
+				number++
+			}
+		case ch == '"':
+			token.WriteByte(ch)
+			inToken = true
+			inQuotes = true
+		case ch == ';':
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+		case ch == '(':
+			flushToken()
+			depth++
+		case ch == ')':
+			flushToken()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", number)
+			}
+			depth--
+		case ch == '\n':
+			flushToken()
+			number++
+			if depth == 0 {
+				lines = append(lines, *current)
+				current = nil
+				startOfLine = true
+			}
+		case ch == ' ' || ch == '\t' || ch == '\r':
+			flushToken()
+		default:
+			token.WriteByte(ch)
+			inToken = true
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", number)
+	}
+	if current != nil {
+		flushToken()
+		lines = append(lines, *current)
+	}
+
+	return lines, nil
+}
+
+// unquoteCharacterString returns the content of a character string, removing the quotes and the escapes.
+func unquoteCharacterString(token string) string {
+
+	token = strings.TrimSuffix(strings.TrimPrefix(token, "\""), "\"")
+
+	var text strings.Builder
+	for i := 0; i < len(token); i++ {
+		if token[i] != '\\' || i+1 == len(token) {
+			text.WriteByte(token[i])
+			continue
+		}
+
+		// An escape is either a backslash followed by three decimal digits or by the escaped character
+		if i+3 < len(token) {
+			if code, err := strconv.ParseUint(token[i+1:i+4], 10, 8); err == nil {
+				text.WriteByte(byte(code))
+				i += 3
+				continue
+			}
+		}
+		text.WriteByte(token[i+1])
+		i++
+	}
+
+	return text.String()
+}
+
+func parseZoneFileTTL(value string) (int64, error) {
+
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return n, nil
+	}
+
+	var total, n int64
+	digits := false
+	for _, ch := range strings.ToLower(value) {
+		switch {
+		case ch >= '0' && ch <= '9':
+			n = n*10 + int64(ch-'0')
+			digits = true
+			continue
+		case !digits:
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		case ch == 's':
+		case ch == 'm':
+			n *= 60
+		case ch == 'h':
+			n *= 3600
+		case ch == 'd':
+			n *= 86400
+		case ch == 'w':
+			n *= 604800
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+		total += n
+		n = 0
+		digits = false
+	}
+	if digits {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+
+	return total, nil
+}
+
+func qualifyName(name string, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	case origin == ".":
+		return name + "."
+	}
+	return name + "." + origin
+}
+
+func fqdn(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
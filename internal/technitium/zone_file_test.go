@@ -0,0 +1,101 @@
+package technitium
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseZoneFile(t *testing.T) {
+
+	t.Run("relative names and multi-line records", func(t *testing.T) {
+		content := `$TTL 1h
+@	IN	SOA	ns1 hostadmin (
+		3	; serial
+		900 300 604800 900 )
+	IN	NS	ns1
+www	300	A	192.0.2.1 ; web server
+	IN	AAAA	2001:db8::1
+@	MX	10 Mail.Example.COM.
+txt	TXT	"a ; not a comment" "b"
+$ORIGIN sub.example.com.
+srv	SRV	10 60 5060 sip
+`
+		records, err := ParseZoneFile(content, "example.com")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		expected := []ZoneFileRecord{
+			{Name: "example.com.", TTL: 3600, Type: "SOA", RData: []string{"ns1.example.com.", "hostadmin.example.com.", "3", "900", "300", "604800", "900"}},
+			{Name: "example.com.", TTL: 3600, Type: "NS", RData: []string{"ns1.example.com."}},
+			{Name: "www.example.com.", TTL: 300, Type: "A", RData: []string{"192.0.2.1"}},
+			{Name: "www.example.com.", TTL: 3600, Type: "AAAA", RData: []string{"2001:db8::1"}},
+			{Name: "example.com.", TTL: 3600, Type: "MX", RData: []string{"10", "mail.example.com."}},
+			{Name: "txt.example.com.", TTL: 3600, Type: "TXT", RData: []string{`"a ; not a comment"`, `"b"`}},
+			{Name: "srv.sub.example.com.", TTL: 3600, Type: "SRV", RData: []string{"10", "60", "5060", "sip.sub.example.com."}},
+		}
+		if !reflect.DeepEqual(records, expected) {
+			t.Errorf("Record mismatch:\nExpected: %+v\nGot:      %+v", expected, records)
+		}
+	})
+
+	t.Run("exported zone", func(t *testing.T) {
+		data, err := os.ReadFile("../test/mocks/dns_zone_export_response.txt")
+		if err != nil {
+			t.Fatalf("failed to read mock file: %v", err)
+		}
+
+		records, err := ParseZoneFile(string(data), "example.com")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(records) != 5 {
+			t.Fatalf("Expected 5 records, got %d", len(records))
+		}
+		if records[3].Key() != "example.com. MX 10 mail.example.com" {
+			t.Errorf("Unexpected key %q", records[3].Key())
+		}
+	})
+
+	t.Run("records differing in formatting", func(t *testing.T) {
+		content := `www	AAAA	2001:db8::1
+txt	TXT	"v=spf1 -all"
+@	DS	12345 ECDSAP256SHA256 SHA256 ( 2BB183AF5F22588179A53B0A
+		98631FAD1A292118 )
+@	SSHFP	ECDSA SHA256 abcdef0123
+`
+		exported := `www	3600	IN	AAAA	2001:0db8:0:0:0:0:0:1
+txt	3600	IN	TXT	"v=spf1" " -all"
+@	3600	IN	DS	12345 ECDSAP256SHA256 SHA256 2bb183af5f22588179a53b0a98631fad1a292118
+@	3600	IN	SSHFP	ECDSA SHA256 ABCDEF0123
+`
+		records, err := ParseZoneFile(content, "example.com")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		current, err := ParseZoneFile(exported, "example.com")
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		for i := range records {
+			if records[i].Key() != current[i].Key() {
+				t.Errorf("Expected key %q, got %q", records[i].Key(), current[i].Key())
+			}
+		}
+	})
+
+	t.Run("invalid zone file", func(t *testing.T) {
+		_, err := ParseZoneFile("www IN A (192.0.2.1\n", "example.com")
+		if err == nil || !strings.Contains(err.Error(), "unbalanced parentheses") {
+			t.Errorf("Expected unbalanced parentheses error, got %v", err)
+		}
+
+		_, err = ParseZoneFile("$INCLUDE other.zone\n", "example.com")
+		if err == nil || !strings.Contains(err.Error(), "$INCLUDE is not supported") {
+			t.Errorf("Expected unsupported directive error, got %v", err)
+		}
+	})
+}